@@ -9,6 +9,9 @@ package protos
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,13 +23,124 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Order in which homeworks are listed. Homeworks without a due date come last
+// when ordering by due date, whether ascending or descending.
+type HomeworkOrder int32
+
+const (
+	// Defaults to ordering by due date.
+	HomeworkOrder_HOMEWORK_ORDER_UNSPECIFIED HomeworkOrder = 0
+	HomeworkOrder_HOMEWORK_ORDER_DUE_DATE    HomeworkOrder = 1
+	HomeworkOrder_HOMEWORK_ORDER_CREATED_AT  HomeworkOrder = 2
+)
+
+// Enum value maps for HomeworkOrder.
+var (
+	HomeworkOrder_name = map[int32]string{
+		0: "HOMEWORK_ORDER_UNSPECIFIED",
+		1: "HOMEWORK_ORDER_DUE_DATE",
+		2: "HOMEWORK_ORDER_CREATED_AT",
+	}
+	HomeworkOrder_value = map[string]int32{
+		"HOMEWORK_ORDER_UNSPECIFIED": 0,
+		"HOMEWORK_ORDER_DUE_DATE":    1,
+		"HOMEWORK_ORDER_CREATED_AT":  2,
+	}
+)
+
+func (x HomeworkOrder) Enum() *HomeworkOrder {
+	p := new(HomeworkOrder)
+	*p = x
+	return p
+}
+
+func (x HomeworkOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HomeworkOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_homework_microservice_proto_enumTypes[0].Descriptor()
+}
+
+func (HomeworkOrder) Type() protoreflect.EnumType {
+	return &file_homework_microservice_proto_enumTypes[0]
+}
+
+func (x HomeworkOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HomeworkOrder.Descriptor instead.
+func (HomeworkOrder) EnumDescriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{0}
+}
+
+// The state of a regrade request.
+type RegradeStatus int32
+
+const (
+	RegradeStatus_REGRADE_STATUS_UNSPECIFIED RegradeStatus = 0
+	RegradeStatus_REGRADE_STATUS_OPEN        RegradeStatus = 1
+	RegradeStatus_REGRADE_STATUS_ACCEPTED    RegradeStatus = 2
+	RegradeStatus_REGRADE_STATUS_REJECTED    RegradeStatus = 3
+)
+
+// Enum value maps for RegradeStatus.
+var (
+	RegradeStatus_name = map[int32]string{
+		0: "REGRADE_STATUS_UNSPECIFIED",
+		1: "REGRADE_STATUS_OPEN",
+		2: "REGRADE_STATUS_ACCEPTED",
+		3: "REGRADE_STATUS_REJECTED",
+	}
+	RegradeStatus_value = map[string]int32{
+		"REGRADE_STATUS_UNSPECIFIED": 0,
+		"REGRADE_STATUS_OPEN":        1,
+		"REGRADE_STATUS_ACCEPTED":    2,
+		"REGRADE_STATUS_REJECTED":    3,
+	}
+)
+
+func (x RegradeStatus) Enum() *RegradeStatus {
+	p := new(RegradeStatus)
+	*p = x
+	return p
+}
+
+func (x RegradeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RegradeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_homework_microservice_proto_enumTypes[1].Descriptor()
+}
+
+func (RegradeStatus) Type() protoreflect.EnumType {
+	return &file_homework_microservice_proto_enumTypes[1]
+}
+
+func (x RegradeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RegradeStatus.Descriptor instead.
+func (RegradeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{1}
+}
+
 // Request message for getting homework containing the course id.
 type GetHomeworkRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Return file contents inline; by default only file metadata is returned.
+	IncludeContent bool `protobuf:"varint,3,opt,name=includeContent,proto3" json:"includeContent,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetHomeworkRequest) Reset() {
@@ -59,6 +173,7 @@ func (*GetHomeworkRequest) Descriptor() ([]byte, []int) {
 	return file_homework_microservice_proto_rawDescGZIP(), []int{0}
 }
 
+// Deprecated: Marked as deprecated in homework-microservice.proto.
 func (x *GetHomeworkRequest) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -73,6 +188,13 @@ func (x *GetHomeworkRequest) GetId() string {
 	return ""
 }
 
+func (x *GetHomeworkRequest) GetIncludeContent() bool {
+	if x != nil {
+		return x.IncludeContent
+	}
+	return false
+}
+
 // Response message containing the homework for said course.
 type GetHomeworkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -118,18 +240,195 @@ func (x *GetHomeworkResponse) GetHw() *Homework {
 	return nil
 }
 
+// Request message for listing the homeworks of a course.
+type ListHomeworksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token    string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseId string `protobuf:"bytes,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	// Only homeworks due at or after this time.
+	DueAfter *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=dueAfter,proto3" json:"dueAfter,omitempty"`
+	// Only homeworks due before this time.
+	DueBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dueBefore,proto3" json:"dueBefore,omitempty"`
+	// Only homeworks whose title contains this text, ignoring case.
+	TitleContains string        `protobuf:"bytes,5,opt,name=titleContains,proto3" json:"titleContains,omitempty"`
+	OrderBy       HomeworkOrder `protobuf:"varint,6,opt,name=orderBy,proto3,enum=Homework.HomeworkOrder" json:"orderBy,omitempty"`
+	Descending    bool          `protobuf:"varint,7,opt,name=descending,proto3" json:"descending,omitempty"`
+	// Maximum number of homeworks per page; defaults to 50 and is capped at 200.
+	PageSize int32 `protobuf:"varint,8,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// The nextPageToken of a previous response, sent with the same filters and ordering.
+	PageToken     string `protobuf:"bytes,9,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHomeworksRequest) Reset() {
+	*x = ListHomeworksRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHomeworksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHomeworksRequest) ProtoMessage() {}
+
+func (x *ListHomeworksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHomeworksRequest.ProtoReflect.Descriptor instead.
+func (*ListHomeworksRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{2}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *ListHomeworksRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListHomeworksRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ListHomeworksRequest) GetDueAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAfter
+	}
+	return nil
+}
+
+func (x *ListHomeworksRequest) GetDueBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueBefore
+	}
+	return nil
+}
+
+func (x *ListHomeworksRequest) GetTitleContains() string {
+	if x != nil {
+		return x.TitleContains
+	}
+	return ""
+}
+
+func (x *ListHomeworksRequest) GetOrderBy() HomeworkOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return HomeworkOrder_HOMEWORK_ORDER_UNSPECIFIED
+}
+
+func (x *ListHomeworksRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListHomeworksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListHomeworksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Response message containing a page of homeworks, without their submissions.
+type ListHomeworksResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Homeworks []*Homework            `protobuf:"bytes,1,rep,name=homeworks,proto3" json:"homeworks,omitempty"`
+	// Token for the next page; empty when there are no more homeworks.
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHomeworksResponse) Reset() {
+	*x = ListHomeworksResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHomeworksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHomeworksResponse) ProtoMessage() {}
+
+func (x *ListHomeworksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHomeworksResponse.ProtoReflect.Descriptor instead.
+func (*ListHomeworksResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListHomeworksResponse) GetHomeworks() []*Homework {
+	if x != nil {
+		return x.Homeworks
+	}
+	return nil
+}
+
+func (x *ListHomeworksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 // Request message for creating new homework.
 type CreateHomeworkRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Homework      *Homework              `protobuf:"bytes,2,opt,name=homework,proto3" json:"homework,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token         string    `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Homework      *Homework `protobuf:"bytes,2,opt,name=homework,proto3" json:"homework,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateHomeworkRequest) Reset() {
 	*x = CreateHomeworkRequest{}
-	mi := &file_homework_microservice_proto_msgTypes[2]
+	mi := &file_homework_microservice_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -141,7 +440,7 @@ func (x *CreateHomeworkRequest) String() string {
 func (*CreateHomeworkRequest) ProtoMessage() {}
 
 func (x *CreateHomeworkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[2]
+	mi := &file_homework_microservice_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -154,9 +453,10 @@ func (x *CreateHomeworkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateHomeworkRequest.ProtoReflect.Descriptor instead.
 func (*CreateHomeworkRequest) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{2}
+	return file_homework_microservice_proto_rawDescGZIP(), []int{4}
 }
 
+// Deprecated: Marked as deprecated in homework-microservice.proto.
 func (x *CreateHomeworkRequest) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -181,7 +481,7 @@ type CreateHomeworkResponse struct {
 
 func (x *CreateHomeworkResponse) Reset() {
 	*x = CreateHomeworkResponse{}
-	mi := &file_homework_microservice_proto_msgTypes[3]
+	mi := &file_homework_microservice_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -193,7 +493,7 @@ func (x *CreateHomeworkResponse) String() string {
 func (*CreateHomeworkResponse) ProtoMessage() {}
 
 func (x *CreateHomeworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[3]
+	mi := &file_homework_microservice_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -206,7 +506,7 @@ func (x *CreateHomeworkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateHomeworkResponse.ProtoReflect.Descriptor instead.
 func (*CreateHomeworkResponse) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{3}
+	return file_homework_microservice_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateHomeworkResponse) GetHw() *Homework {
@@ -218,16 +518,25 @@ func (x *CreateHomeworkResponse) GetHw() *Homework {
 
 // Request message for updating a homework.
 type UpdateHomeworkRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Homework      *Homework              `protobuf:"bytes,2,opt,name=homework,proto3" json:"homework,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The homework as it should be stored, with the version it was read at. With an
+	// updateMask, only the listed fields need to be set.
+	Homework *Homework `protobuf:"bytes,2,opt,name=homework,proto3" json:"homework,omitempty"`
+	// The homework fields to update, e.g. "dueDate"; the others keep their stored values.
+	// Empty replaces every field. id, createdAt, version and submissions cannot be listed.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateHomeworkRequest) Reset() {
 	*x = UpdateHomeworkRequest{}
-	mi := &file_homework_microservice_proto_msgTypes[4]
+	mi := &file_homework_microservice_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -239,7 +548,7 @@ func (x *UpdateHomeworkRequest) String() string {
 func (*UpdateHomeworkRequest) ProtoMessage() {}
 
 func (x *UpdateHomeworkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[4]
+	mi := &file_homework_microservice_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,9 +561,10 @@ func (x *UpdateHomeworkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateHomeworkRequest.ProtoReflect.Descriptor instead.
 func (*UpdateHomeworkRequest) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{4}
+	return file_homework_microservice_proto_rawDescGZIP(), []int{6}
 }
 
+// Deprecated: Marked as deprecated in homework-microservice.proto.
 func (x *UpdateHomeworkRequest) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -269,7 +579,14 @@ func (x *UpdateHomeworkRequest) GetHomework() *Homework {
 	return nil
 }
 
-// Response message for updating a homework.
+func (x *UpdateHomeworkRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// Response message containing the homework as stored, at its new version, without its submissions.
 type UpdateHomeworkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Hw            *Homework              `protobuf:"bytes,1,opt,name=hw,proto3" json:"hw,omitempty"`
@@ -279,7 +596,7 @@ type UpdateHomeworkResponse struct {
 
 func (x *UpdateHomeworkResponse) Reset() {
 	*x = UpdateHomeworkResponse{}
-	mi := &file_homework_microservice_proto_msgTypes[5]
+	mi := &file_homework_microservice_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -291,7 +608,7 @@ func (x *UpdateHomeworkResponse) String() string {
 func (*UpdateHomeworkResponse) ProtoMessage() {}
 
 func (x *UpdateHomeworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[5]
+	mi := &file_homework_microservice_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +621,7 @@ func (x *UpdateHomeworkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateHomeworkResponse.ProtoReflect.Descriptor instead.
 func (*UpdateHomeworkResponse) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{5}
+	return file_homework_microservice_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateHomeworkResponse) GetHw() *Homework {
@@ -316,16 +633,20 @@ func (x *UpdateHomeworkResponse) GetHw() *Homework {
 
 // Request message for deleting a homework.
 type DeleteHomeworkRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteHomeworkRequest) Reset() {
 	*x = DeleteHomeworkRequest{}
-	mi := &file_homework_microservice_proto_msgTypes[6]
+	mi := &file_homework_microservice_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -337,7 +658,7 @@ func (x *DeleteHomeworkRequest) String() string {
 func (*DeleteHomeworkRequest) ProtoMessage() {}
 
 func (x *DeleteHomeworkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[6]
+	mi := &file_homework_microservice_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,9 +671,10 @@ func (x *DeleteHomeworkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteHomeworkRequest.ProtoReflect.Descriptor instead.
 func (*DeleteHomeworkRequest) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{6}
+	return file_homework_microservice_proto_rawDescGZIP(), []int{8}
 }
 
+// Deprecated: Marked as deprecated in homework-microservice.proto.
 func (x *DeleteHomeworkRequest) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -376,7 +698,7 @@ type DeleteHomeworkResponse struct {
 
 func (x *DeleteHomeworkResponse) Reset() {
 	*x = DeleteHomeworkResponse{}
-	mi := &file_homework_microservice_proto_msgTypes[7]
+	mi := &file_homework_microservice_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -388,7 +710,7 @@ func (x *DeleteHomeworkResponse) String() string {
 func (*DeleteHomeworkResponse) ProtoMessage() {}
 
 func (x *DeleteHomeworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[7]
+	mi := &file_homework_microservice_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,22 +723,26 @@ func (x *DeleteHomeworkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteHomeworkResponse.ProtoReflect.Descriptor instead.
 func (*DeleteHomeworkResponse) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{7}
+	return file_homework_microservice_proto_rawDescGZIP(), []int{9}
 }
 
 // Request message for submitting a homework.
 type SubmitHomeworkRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Submission    *Submission            `protobuf:"bytes,3,opt,name=submission,proto3" json:"submission,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token         string      `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            string      `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Submission    *Submission `protobuf:"bytes,3,opt,name=submission,proto3" json:"submission,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubmitHomeworkRequest) Reset() {
 	*x = SubmitHomeworkRequest{}
-	mi := &file_homework_microservice_proto_msgTypes[8]
+	mi := &file_homework_microservice_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -428,7 +754,7 @@ func (x *SubmitHomeworkRequest) String() string {
 func (*SubmitHomeworkRequest) ProtoMessage() {}
 
 func (x *SubmitHomeworkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[8]
+	mi := &file_homework_microservice_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,9 +767,10 @@ func (x *SubmitHomeworkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitHomeworkRequest.ProtoReflect.Descriptor instead.
 func (*SubmitHomeworkRequest) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{8}
+	return file_homework_microservice_proto_rawDescGZIP(), []int{10}
 }
 
+// Deprecated: Marked as deprecated in homework-microservice.proto.
 func (x *SubmitHomeworkRequest) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -475,7 +802,7 @@ type SubmitHomeworkResponse struct {
 
 func (x *SubmitHomeworkResponse) Reset() {
 	*x = SubmitHomeworkResponse{}
-	mi := &file_homework_microservice_proto_msgTypes[9]
+	mi := &file_homework_microservice_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -487,7 +814,7 @@ func (x *SubmitHomeworkResponse) String() string {
 func (*SubmitHomeworkResponse) ProtoMessage() {}
 
 func (x *SubmitHomeworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[9]
+	mi := &file_homework_microservice_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +827,7 @@ func (x *SubmitHomeworkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitHomeworkResponse.ProtoReflect.Descriptor instead.
 func (*SubmitHomeworkResponse) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{9}
+	return file_homework_microservice_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SubmitHomeworkResponse) GetSubmission() *Submission {
@@ -512,16 +839,22 @@ func (x *SubmitHomeworkResponse) GetSubmission() *Submission {
 
 // Request message to retrieve all submissions for a homework.
 type GetSubmissionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	HomeworkId    string                 `protobuf:"bytes,2,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token      string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	HomeworkId string `protobuf:"bytes,2,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	// Return file contents inline; by default only file metadata is returned.
+	IncludeContent bool `protobuf:"varint,3,opt,name=includeContent,proto3" json:"includeContent,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GetSubmissionsRequest) Reset() {
 	*x = GetSubmissionsRequest{}
-	mi := &file_homework_microservice_proto_msgTypes[10]
+	mi := &file_homework_microservice_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -533,7 +866,7 @@ func (x *GetSubmissionsRequest) String() string {
 func (*GetSubmissionsRequest) ProtoMessage() {}
 
 func (x *GetSubmissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[10]
+	mi := &file_homework_microservice_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,9 +879,10 @@ func (x *GetSubmissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubmissionsRequest.ProtoReflect.Descriptor instead.
 func (*GetSubmissionsRequest) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{10}
+	return file_homework_microservice_proto_rawDescGZIP(), []int{12}
 }
 
+// Deprecated: Marked as deprecated in homework-microservice.proto.
 func (x *GetSubmissionsRequest) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -563,6 +897,13 @@ func (x *GetSubmissionsRequest) GetHomeworkId() string {
 	return ""
 }
 
+func (x *GetSubmissionsRequest) GetIncludeContent() bool {
+	if x != nil {
+		return x.IncludeContent
+	}
+	return false
+}
+
 // Response message containing all submissions for a homework.
 type GetSubmissionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -573,7 +914,7 @@ type GetSubmissionsResponse struct {
 
 func (x *GetSubmissionsResponse) Reset() {
 	*x = GetSubmissionsResponse{}
-	mi := &file_homework_microservice_proto_msgTypes[11]
+	mi := &file_homework_microservice_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -585,7 +926,7 @@ func (x *GetSubmissionsResponse) String() string {
 func (*GetSubmissionsResponse) ProtoMessage() {}
 
 func (x *GetSubmissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[11]
+	mi := &file_homework_microservice_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,7 +939,7 @@ func (x *GetSubmissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubmissionsResponse.ProtoReflect.Descriptor instead.
 func (*GetSubmissionsResponse) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{11}
+	return file_homework_microservice_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetSubmissionsResponse) GetSubmissions() []*Submission {
@@ -608,30 +949,35 @@ func (x *GetSubmissionsResponse) GetSubmissions() []*Submission {
 	return nil
 }
 
-// Request message for getting submissions of a specific student.
-type GetStudentSubmissionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+// Request message for listing the submitted versions of a student's homework.
+type ListSubmissionVersionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	HomeworkId    string `protobuf:"bytes,2,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	StudentId     string `protobuf:"bytes,3,opt,name=studentId,proto3" json:"studentId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStudentSubmissionsRequest) Reset() {
-	*x = GetStudentSubmissionsRequest{}
-	mi := &file_homework_microservice_proto_msgTypes[12]
+func (x *ListSubmissionVersionsRequest) Reset() {
+	*x = ListSubmissionVersionsRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentSubmissionsRequest) String() string {
+func (x *ListSubmissionVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentSubmissionsRequest) ProtoMessage() {}
+func (*ListSubmissionVersionsRequest) ProtoMessage() {}
 
-func (x *GetStudentSubmissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[12]
+func (x *ListSubmissionVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -642,12 +988,228 @@ func (x *GetStudentSubmissionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentSubmissionsRequest.ProtoReflect.Descriptor instead.
-func (*GetStudentSubmissionsRequest) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListSubmissionVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubmissionVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetStudentSubmissionsRequest) GetToken() string {
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *ListSubmissionVersionsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListSubmissionVersionsRequest) GetHomeworkId() string {
+	if x != nil {
+		return x.HomeworkId
+	}
+	return ""
+}
+
+func (x *ListSubmissionVersionsRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+// Response message containing the submitted versions, oldest first.
+type ListSubmissionVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submissions   []*Submission          `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubmissionVersionsResponse) Reset() {
+	*x = ListSubmissionVersionsResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubmissionVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubmissionVersionsResponse) ProtoMessage() {}
+
+func (x *ListSubmissionVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubmissionVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubmissionVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListSubmissionVersionsResponse) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+// Request message for marking a submitted version as final.
+type SelectFinalSubmissionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	SubmissionId  string `protobuf:"bytes,2,opt,name=submissionId,proto3" json:"submissionId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectFinalSubmissionRequest) Reset() {
+	*x = SelectFinalSubmissionRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectFinalSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectFinalSubmissionRequest) ProtoMessage() {}
+
+func (x *SelectFinalSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectFinalSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*SelectFinalSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{16}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *SelectFinalSubmissionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SelectFinalSubmissionRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+// Response message containing the submission now marked as final.
+type SelectFinalSubmissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submission    *Submission            `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectFinalSubmissionResponse) Reset() {
+	*x = SelectFinalSubmissionResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectFinalSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectFinalSubmissionResponse) ProtoMessage() {}
+
+func (x *SelectFinalSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectFinalSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*SelectFinalSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SelectFinalSubmissionResponse) GetSubmission() *Submission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+// Request message for getting submissions of a specific student.
+type GetStudentSubmissionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token     string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudentId string `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	// Return file contents inline; by default only file metadata is returned.
+	IncludeContent bool `protobuf:"varint,3,opt,name=includeContent,proto3" json:"includeContent,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetStudentSubmissionsRequest) Reset() {
+	*x = GetStudentSubmissionsRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentSubmissionsRequest) ProtoMessage() {}
+
+func (x *GetStudentSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{18}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *GetStudentSubmissionsRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
@@ -656,34 +1218,2331 @@ func (x *GetStudentSubmissionsRequest) GetToken() string {
 
 func (x *GetStudentSubmissionsRequest) GetStudentId() string {
 	if x != nil {
-		return x.StudentId
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetStudentSubmissionsRequest) GetIncludeContent() bool {
+	if x != nil {
+		return x.IncludeContent
+	}
+	return false
+}
+
+// Response message containing all submissions of a specific student.
+type GetStudentSubmissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submissions   []*Submission          `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentSubmissionsResponse) Reset() {
+	*x = GetStudentSubmissionsResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentSubmissionsResponse) ProtoMessage() {}
+
+func (x *GetStudentSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetStudentSubmissionsResponse) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+// Request message for uploading a file in chunks.
+// The first message carries the header, followed by the content chunks,
+// and the last message carries the checksum of the whole content.
+type UploadFileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadFileRequest_Header
+	//	*UploadFileRequest_Chunk
+	//	*UploadFileRequest_Sha256
+	Data          isUploadFileRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFileRequest) Reset() {
+	*x = UploadFileRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileRequest) ProtoMessage() {}
+
+func (x *UploadFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileRequest.ProtoReflect.Descriptor instead.
+func (*UploadFileRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UploadFileRequest) GetData() isUploadFileRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadFileRequest) GetHeader() *UploadFileHeader {
+	if x != nil {
+		if x, ok := x.Data.(*UploadFileRequest_Header); ok {
+			return x.Header
+		}
+	}
+	return nil
+}
+
+func (x *UploadFileRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadFileRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+func (x *UploadFileRequest) GetSha256() string {
+	if x != nil {
+		if x, ok := x.Data.(*UploadFileRequest_Sha256); ok {
+			return x.Sha256
+		}
+	}
+	return ""
+}
+
+type isUploadFileRequest_Data interface {
+	isUploadFileRequest_Data()
+}
+
+type UploadFileRequest_Header struct {
+	Header *UploadFileHeader `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type UploadFileRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+type UploadFileRequest_Sha256 struct {
+	// Hex-encoded SHA-256 digest of the whole content.
+	Sha256 string `protobuf:"bytes,3,opt,name=sha256,proto3,oneof"`
+}
+
+func (*UploadFileRequest_Header) isUploadFileRequest_Data() {}
+
+func (*UploadFileRequest_Chunk) isUploadFileRequest_Data() {}
+
+func (*UploadFileRequest_Sha256) isUploadFileRequest_Data() {}
+
+// Header message describing the uploaded file.
+type UploadFileHeader struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The homework the file will be attached to, either as a homework file or a submission.
+	HomeworkId    string `protobuf:"bytes,2,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	Filename      string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	MimeType      string `protobuf:"bytes,4,opt,name=mimeType,proto3" json:"mimeType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFileHeader) Reset() {
+	*x = UploadFileHeader{}
+	mi := &file_homework_microservice_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFileHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileHeader) ProtoMessage() {}
+
+func (x *UploadFileHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileHeader.ProtoReflect.Descriptor instead.
+func (*UploadFileHeader) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{21}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *UploadFileHeader) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UploadFileHeader) GetHomeworkId() string {
+	if x != nil {
+		return x.HomeworkId
+	}
+	return ""
+}
+
+func (x *UploadFileHeader) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadFileHeader) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+// Response message containing the uploaded file, without its content.
+// Attach it to a homework or submission by sending it back with only contentRef set.
+// Only the uploader, or staff of the course, may attach it.
+type UploadFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          *File                  `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFileResponse) Reset() {
+	*x = UploadFileResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileResponse) ProtoMessage() {}
+
+func (x *UploadFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileResponse.ProtoReflect.Descriptor instead.
+func (*UploadFileResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UploadFileResponse) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+// Request message for downloading a homework or submission file.
+type DownloadFileRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token      string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	HomeworkId string `protobuf:"bytes,2,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	ContentRef string `protobuf:"bytes,3,opt,name=contentRef,proto3" json:"contentRef,omitempty"`
+	// Byte offset to start from, used to resume an interrupted download.
+	Offset        int64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadFileRequest) Reset() {
+	*x = DownloadFileRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileRequest) ProtoMessage() {}
+
+func (x *DownloadFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFileRequest.ProtoReflect.Descriptor instead.
+func (*DownloadFileRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{23}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *DownloadFileRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DownloadFileRequest) GetHomeworkId() string {
+	if x != nil {
+		return x.HomeworkId
+	}
+	return ""
+}
+
+func (x *DownloadFileRequest) GetContentRef() string {
+	if x != nil {
+		return x.ContentRef
+	}
+	return ""
+}
+
+func (x *DownloadFileRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Response message carrying one chunk of a downloaded file.
+type DownloadFileResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The file metadata, sent with the first chunk only.
+	File *File `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	// Byte offset of this chunk within the file.
+	Offset        int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Chunk         []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadFileResponse) Reset() {
+	*x = DownloadFileResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileResponse) ProtoMessage() {}
+
+func (x *DownloadFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFileResponse.ProtoReflect.Descriptor instead.
+func (*DownloadFileResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DownloadFileResponse) GetFile() *File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *DownloadFileResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadFileResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// Request message for granting extensions on a homework.
+type GrantExtensionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token      string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	HomeworkId string `protobuf:"bytes,2,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	// The students receiving the extension; a group is granted by listing all of its members.
+	StudentIds    []string               `protobuf:"bytes,3,rep,name=studentIds,proto3" json:"studentIds,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=dueDate,proto3" json:"dueDate,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantExtensionRequest) Reset() {
+	*x = GrantExtensionRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantExtensionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantExtensionRequest) ProtoMessage() {}
+
+func (x *GrantExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantExtensionRequest.ProtoReflect.Descriptor instead.
+func (*GrantExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{25}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *GrantExtensionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GrantExtensionRequest) GetHomeworkId() string {
+	if x != nil {
+		return x.HomeworkId
+	}
+	return ""
+}
+
+func (x *GrantExtensionRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+func (x *GrantExtensionRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *GrantExtensionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Response message containing the granted extensions.
+type GrantExtensionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Extensions    []*Extension           `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantExtensionResponse) Reset() {
+	*x = GrantExtensionResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantExtensionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantExtensionResponse) ProtoMessage() {}
+
+func (x *GrantExtensionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantExtensionResponse.ProtoReflect.Descriptor instead.
+func (*GrantExtensionResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GrantExtensionResponse) GetExtensions() []*Extension {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// Request message for revoking extensions on a homework.
+type RevokeExtensionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token         string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	HomeworkId    string   `protobuf:"bytes,2,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	StudentIds    []string `protobuf:"bytes,3,rep,name=studentIds,proto3" json:"studentIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeExtensionRequest) Reset() {
+	*x = RevokeExtensionRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeExtensionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeExtensionRequest) ProtoMessage() {}
+
+func (x *RevokeExtensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeExtensionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeExtensionRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{27}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *RevokeExtensionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RevokeExtensionRequest) GetHomeworkId() string {
+	if x != nil {
+		return x.HomeworkId
+	}
+	return ""
+}
+
+func (x *RevokeExtensionRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+// Response message for revoking extensions.
+type RevokeExtensionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeExtensionResponse) Reset() {
+	*x = RevokeExtensionResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeExtensionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeExtensionResponse) ProtoMessage() {}
+
+func (x *RevokeExtensionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeExtensionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeExtensionResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{28}
+}
+
+// Request message for listing the extensions granted on a homework.
+type ListExtensionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	HomeworkId    string `protobuf:"bytes,2,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExtensionsRequest) Reset() {
+	*x = ListExtensionsRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExtensionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExtensionsRequest) ProtoMessage() {}
+
+func (x *ListExtensionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExtensionsRequest.ProtoReflect.Descriptor instead.
+func (*ListExtensionsRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{29}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *ListExtensionsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListExtensionsRequest) GetHomeworkId() string {
+	if x != nil {
+		return x.HomeworkId
+	}
+	return ""
+}
+
+// Response message containing the extensions granted on a homework.
+type ListExtensionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Extensions    []*Extension           `protobuf:"bytes,1,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExtensionsResponse) Reset() {
+	*x = ListExtensionsResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExtensionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExtensionsResponse) ProtoMessage() {}
+
+func (x *ListExtensionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExtensionsResponse.ProtoReflect.Descriptor instead.
+func (*ListExtensionsResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListExtensionsResponse) GetExtensions() []*Extension {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+// Message representing a student's individual due date for a homework.
+// Submissions and late detection use it instead of the homework's due date.
+type Extension struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	HomeworkId string                 `protobuf:"bytes,1,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	StudentId  string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	DueDate    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=dueDate,proto3" json:"dueDate,omitempty"`
+	Reason     string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Read-only; set by the server when the extension is granted.
+	GrantedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=grantedAt,proto3" json:"grantedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Extension) Reset() {
+	*x = Extension{}
+	mi := &file_homework_microservice_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Extension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extension) ProtoMessage() {}
+
+func (x *Extension) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Extension.ProtoReflect.Descriptor instead.
+func (*Extension) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Extension) GetHomeworkId() string {
+	if x != nil {
+		return x.HomeworkId
+	}
+	return ""
+}
+
+func (x *Extension) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Extension) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Extension) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Extension) GetGrantedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GrantedAt
+	}
+	return nil
+}
+
+// Request message for grading a submission.
+type SetGradeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token        string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	SubmissionId string `protobuf:"bytes,2,opt,name=submissionId,proto3" json:"submissionId,omitempty"`
+	// Must be unset when grading with a rubric.
+	Score float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	// Must be unset when grading with a rubric.
+	MaxScore float64 `protobuf:"fixed64,4,opt,name=maxScore,proto3" json:"maxScore,omitempty"`
+	Feedback string  `protobuf:"bytes,5,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	// The level selected for each criterion of the homework's rubric.
+	Selections    []*CriterionSelection `protobuf:"bytes,6,rep,name=selections,proto3" json:"selections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGradeRequest) Reset() {
+	*x = SetGradeRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGradeRequest) ProtoMessage() {}
+
+func (x *SetGradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGradeRequest.ProtoReflect.Descriptor instead.
+func (*SetGradeRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{32}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *SetGradeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SetGradeRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *SetGradeRequest) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SetGradeRequest) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *SetGradeRequest) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *SetGradeRequest) GetSelections() []*CriterionSelection {
+	if x != nil {
+		return x.Selections
+	}
+	return nil
+}
+
+// Response message containing the grade.
+type SetGradeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grade         *Grade                 `protobuf:"bytes,1,opt,name=grade,proto3" json:"grade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGradeResponse) Reset() {
+	*x = SetGradeResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGradeResponse) ProtoMessage() {}
+
+func (x *SetGradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGradeResponse.ProtoReflect.Descriptor instead.
+func (*SetGradeResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetGradeResponse) GetGrade() *Grade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
+}
+
+// Request message for getting the grades of a homework.
+type GetGradesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	HomeworkId    string `protobuf:"bytes,2,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradesRequest) Reset() {
+	*x = GetGradesRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradesRequest) ProtoMessage() {}
+
+func (x *GetGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradesRequest.ProtoReflect.Descriptor instead.
+func (*GetGradesRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{34}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *GetGradesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetGradesRequest) GetHomeworkId() string {
+	if x != nil {
+		return x.HomeworkId
+	}
+	return ""
+}
+
+// Response message containing the grades of a homework.
+type GetGradesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grades        []*Grade               `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradesResponse) Reset() {
+	*x = GetGradesResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradesResponse) ProtoMessage() {}
+
+func (x *GetGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradesResponse.ProtoReflect.Descriptor instead.
+func (*GetGradesResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetGradesResponse) GetGrades() []*Grade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+// Request message for getting the grades of a student.
+type GetStudentGradesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudentId     string `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentGradesRequest) Reset() {
+	*x = GetStudentGradesRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentGradesRequest) ProtoMessage() {}
+
+func (x *GetStudentGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentGradesRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentGradesRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{36}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *GetStudentGradesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetStudentGradesRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+// Response message containing the grades of a student.
+type GetStudentGradesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grades        []*Grade               `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentGradesResponse) Reset() {
+	*x = GetStudentGradesResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentGradesResponse) ProtoMessage() {}
+
+func (x *GetStudentGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentGradesResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentGradesResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetStudentGradesResponse) GetGrades() []*Grade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+// Message representing the grade of a submission.
+type Grade struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId string                 `protobuf:"bytes,1,opt,name=submissionId,proto3" json:"submissionId,omitempty"`
+	// Read-only; copied from the graded submission.
+	HomeworkId string `protobuf:"bytes,2,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	// Read-only; the student who made the graded submission.
+	StudentId string `protobuf:"bytes,3,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	// Read-only; the group the graded submission was made for, if any.
+	GroupId  string  `protobuf:"bytes,4,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Score    float64 `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	MaxScore float64 `protobuf:"fixed64,6,opt,name=maxScore,proto3" json:"maxScore,omitempty"`
+	Feedback string  `protobuf:"bytes,7,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	// Read-only; the late penalty of the graded submission, in percent.
+	PenaltyPercent float64 `protobuf:"fixed64,8,opt,name=penaltyPercent,proto3" json:"penaltyPercent,omitempty"`
+	// Read-only; the score after deducting the late penalty.
+	FinalScore float64 `protobuf:"fixed64,9,opt,name=finalScore,proto3" json:"finalScore,omitempty"`
+	// Read-only; when the submission was first graded.
+	GradedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=gradedAt,proto3" json:"gradedAt,omitempty"`
+	// Read-only; when the grade last changed.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// Read-only; the rubric selections the score was computed from, if any.
+	Selections    []*CriterionSelection `protobuf:"bytes,12,rep,name=selections,proto3" json:"selections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Grade) Reset() {
+	*x = Grade{}
+	mi := &file_homework_microservice_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Grade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grade) ProtoMessage() {}
+
+func (x *Grade) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grade.ProtoReflect.Descriptor instead.
+func (*Grade) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Grade) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *Grade) GetHomeworkId() string {
+	if x != nil {
+		return x.HomeworkId
+	}
+	return ""
+}
+
+func (x *Grade) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Grade) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Grade) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Grade) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *Grade) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Grade) GetPenaltyPercent() float64 {
+	if x != nil {
+		return x.PenaltyPercent
+	}
+	return 0
+}
+
+func (x *Grade) GetFinalScore() float64 {
+	if x != nil {
+		return x.FinalScore
+	}
+	return 0
+}
+
+func (x *Grade) GetGradedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GradedAt
+	}
+	return nil
+}
+
+func (x *Grade) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Grade) GetSelections() []*CriterionSelection {
+	if x != nil {
+		return x.Selections
+	}
+	return nil
+}
+
+// Request message for opening a regrade request.
+type RequestRegradeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token        string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	SubmissionId string `protobuf:"bytes,2,opt,name=submissionId,proto3" json:"submissionId,omitempty"`
+	// The student disputing the grade: the submitter or a member of their group.
+	StudentId     string `protobuf:"bytes,3,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Justification string `protobuf:"bytes,4,opt,name=justification,proto3" json:"justification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestRegradeRequest) Reset() {
+	*x = RequestRegradeRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRegradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRegradeRequest) ProtoMessage() {}
+
+func (x *RequestRegradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRegradeRequest.ProtoReflect.Descriptor instead.
+func (*RequestRegradeRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{39}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *RequestRegradeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RequestRegradeRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *RequestRegradeRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *RequestRegradeRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+// Response message containing the opened regrade request.
+type RequestRegradeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Regrade       *Regrade               `protobuf:"bytes,1,opt,name=regrade,proto3" json:"regrade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestRegradeResponse) Reset() {
+	*x = RequestRegradeResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRegradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRegradeResponse) ProtoMessage() {}
+
+func (x *RequestRegradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRegradeResponse.ProtoReflect.Descriptor instead.
+func (*RequestRegradeResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RequestRegradeResponse) GetRegrade() *Regrade {
+	if x != nil {
+		return x.Regrade
+	}
+	return nil
+}
+
+// Request message for resolving a regrade request.
+type ResolveRegradeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token     string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RegradeId string `protobuf:"bytes,2,opt,name=regradeId,proto3" json:"regradeId,omitempty"`
+	// Either REGRADE_STATUS_ACCEPTED or REGRADE_STATUS_REJECTED.
+	Status   RegradeStatus `protobuf:"varint,3,opt,name=status,proto3,enum=Homework.RegradeStatus" json:"status,omitempty"`
+	Response string        `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+	// The new score; required when accepting and must be unset when rejecting.
+	// It overrides a score computed from a rubric, whose selections are cleared.
+	AdjustedScore *float64 `protobuf:"fixed64,5,opt,name=adjustedScore,proto3,oneof" json:"adjustedScore,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveRegradeRequest) Reset() {
+	*x = ResolveRegradeRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveRegradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveRegradeRequest) ProtoMessage() {}
+
+func (x *ResolveRegradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveRegradeRequest.ProtoReflect.Descriptor instead.
+func (*ResolveRegradeRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{41}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *ResolveRegradeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResolveRegradeRequest) GetRegradeId() string {
+	if x != nil {
+		return x.RegradeId
+	}
+	return ""
+}
+
+func (x *ResolveRegradeRequest) GetStatus() RegradeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RegradeStatus_REGRADE_STATUS_UNSPECIFIED
+}
+
+func (x *ResolveRegradeRequest) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *ResolveRegradeRequest) GetAdjustedScore() float64 {
+	if x != nil && x.AdjustedScore != nil {
+		return *x.AdjustedScore
+	}
+	return 0
+}
+
+// Response message containing the resolved regrade request and, if accepted, the updated grade.
+type ResolveRegradeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Regrade       *Regrade               `protobuf:"bytes,1,opt,name=regrade,proto3" json:"regrade,omitempty"`
+	Grade         *Grade                 `protobuf:"bytes,2,opt,name=grade,proto3" json:"grade,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveRegradeResponse) Reset() {
+	*x = ResolveRegradeResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveRegradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveRegradeResponse) ProtoMessage() {}
+
+func (x *ResolveRegradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveRegradeResponse.ProtoReflect.Descriptor instead.
+func (*ResolveRegradeResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ResolveRegradeResponse) GetRegrade() *Regrade {
+	if x != nil {
+		return x.Regrade
+	}
+	return nil
+}
+
+func (x *ResolveRegradeResponse) GetGrade() *Grade {
+	if x != nil {
+		return x.Grade
+	}
+	return nil
+}
+
+// Request message for listing the open regrade requests of a course.
+type ListOpenRegradesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	CourseId      string `protobuf:"bytes,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpenRegradesRequest) Reset() {
+	*x = ListOpenRegradesRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpenRegradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenRegradesRequest) ProtoMessage() {}
+
+func (x *ListOpenRegradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenRegradesRequest.ProtoReflect.Descriptor instead.
+func (*ListOpenRegradesRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{43}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *ListOpenRegradesRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListOpenRegradesRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+// Response message containing the open regrade requests of a course.
+type ListOpenRegradesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Regrades      []*Regrade             `protobuf:"bytes,1,rep,name=regrades,proto3" json:"regrades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOpenRegradesResponse) Reset() {
+	*x = ListOpenRegradesResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOpenRegradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOpenRegradesResponse) ProtoMessage() {}
+
+func (x *ListOpenRegradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOpenRegradesResponse.ProtoReflect.Descriptor instead.
+func (*ListOpenRegradesResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListOpenRegradesResponse) GetRegrades() []*Regrade {
+	if x != nil {
+		return x.Regrades
+	}
+	return nil
+}
+
+// Message representing a student's dispute of a grade. A submission has at most
+// one open regrade request at a time.
+type Regrade struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubmissionId  string                 `protobuf:"bytes,2,opt,name=submissionId,proto3" json:"submissionId,omitempty"`
+	HomeworkId    string                 `protobuf:"bytes,3,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Justification string                 `protobuf:"bytes,5,opt,name=justification,proto3" json:"justification,omitempty"`
+	Status        RegradeStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=Homework.RegradeStatus" json:"status,omitempty"`
+	// The staff response, set when the request is resolved.
+	Response string `protobuf:"bytes,7,opt,name=response,proto3" json:"response,omitempty"`
+	// The score of the grade when the request was opened.
+	OriginalScore float64 `protobuf:"fixed64,8,opt,name=originalScore,proto3" json:"originalScore,omitempty"`
+	// The maximum score of the grade when the request was opened.
+	MaxScore float64 `protobuf:"fixed64,9,opt,name=maxScore,proto3" json:"maxScore,omitempty"`
+	// The new score, set when the request is accepted.
+	AdjustedScore *float64               `protobuf:"fixed64,10,opt,name=adjustedScore,proto3,oneof" json:"adjustedScore,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=resolvedAt,proto3" json:"resolvedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Regrade) Reset() {
+	*x = Regrade{}
+	mi := &file_homework_microservice_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Regrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Regrade) ProtoMessage() {}
+
+func (x *Regrade) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Regrade.ProtoReflect.Descriptor instead.
+func (*Regrade) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Regrade) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Regrade) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *Regrade) GetHomeworkId() string {
+	if x != nil {
+		return x.HomeworkId
+	}
+	return ""
+}
+
+func (x *Regrade) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Regrade) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *Regrade) GetStatus() RegradeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RegradeStatus_REGRADE_STATUS_UNSPECIFIED
+}
+
+func (x *Regrade) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *Regrade) GetOriginalScore() float64 {
+	if x != nil {
+		return x.OriginalScore
+	}
+	return 0
+}
+
+func (x *Regrade) GetMaxScore() float64 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *Regrade) GetAdjustedScore() float64 {
+	if x != nil && x.AdjustedScore != nil {
+		return *x.AdjustedScore
+	}
+	return 0
+}
+
+func (x *Regrade) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Regrade) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+// Request message for completing a workflow step.
+type AdvanceWorkflowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token      string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	HomeworkId string `protobuf:"bytes,2,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	StudentId  string `protobuf:"bytes,3,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	// The step being completed; must be the student's next step.
+	Step          string `protobuf:"bytes,4,opt,name=step,proto3" json:"step,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdvanceWorkflowRequest) Reset() {
+	*x = AdvanceWorkflowRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdvanceWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvanceWorkflowRequest) ProtoMessage() {}
+
+func (x *AdvanceWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvanceWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*AdvanceWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{46}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *AdvanceWorkflowRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AdvanceWorkflowRequest) GetHomeworkId() string {
+	if x != nil {
+		return x.HomeworkId
+	}
+	return ""
+}
+
+func (x *AdvanceWorkflowRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AdvanceWorkflowRequest) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+// Response message containing the student's updated progress.
+type AdvanceWorkflowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Progress      *WorkflowProgress      `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdvanceWorkflowResponse) Reset() {
+	*x = AdvanceWorkflowResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdvanceWorkflowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvanceWorkflowResponse) ProtoMessage() {}
+
+func (x *AdvanceWorkflowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvanceWorkflowResponse.ProtoReflect.Descriptor instead.
+func (*AdvanceWorkflowResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AdvanceWorkflowResponse) GetProgress() *WorkflowProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+// Request message for getting workflow progress.
+type GetWorkflowProgressRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: send the token as "authorization: Bearer <token>" metadata instead.
+	// Still accepted from clients that do not send the metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token      string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	HomeworkId string `protobuf:"bytes,2,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	// The student to get the progress of; empty for every student who started the workflow.
+	StudentId     string `protobuf:"bytes,3,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkflowProgressRequest) Reset() {
+	*x = GetWorkflowProgressRequest{}
+	mi := &file_homework_microservice_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkflowProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowProgressRequest) ProtoMessage() {}
+
+func (x *GetWorkflowProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkflowProgressRequest) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{48}
+}
+
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *GetWorkflowProgressRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetWorkflowProgressRequest) GetHomeworkId() string {
+	if x != nil {
+		return x.HomeworkId
+	}
+	return ""
+}
+
+func (x *GetWorkflowProgressRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+// Response message containing workflow progress, ordered by student.
+type GetWorkflowProgressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Progress      []*WorkflowProgress    `protobuf:"bytes,1,rep,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkflowProgressResponse) Reset() {
+	*x = GetWorkflowProgressResponse{}
+	mi := &file_homework_microservice_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkflowProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowProgressResponse) ProtoMessage() {}
+
+func (x *GetWorkflowProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkflowProgressResponse) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetWorkflowProgressResponse) GetProgress() []*WorkflowProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+// Message representing a student's progress through a homework's workflow.
+type WorkflowProgress struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	HomeworkId string                 `protobuf:"bytes,1,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	StudentId  string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	// The completed steps, in the order they were completed. Steps are matched by name, so
+	// completions of steps since removed from the workflow are left out.
+	CompletedSteps []*StepCompletion `protobuf:"bytes,3,rep,name=completedSteps,proto3" json:"completedSteps,omitempty"`
+	// The step to complete next; empty once the workflow is done.
+	NextStep      string `protobuf:"bytes,4,opt,name=nextStep,proto3" json:"nextStep,omitempty"`
+	Done          bool   `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowProgress) Reset() {
+	*x = WorkflowProgress{}
+	mi := &file_homework_microservice_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowProgress) ProtoMessage() {}
+
+func (x *WorkflowProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowProgress.ProtoReflect.Descriptor instead.
+func (*WorkflowProgress) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *WorkflowProgress) GetHomeworkId() string {
+	if x != nil {
+		return x.HomeworkId
+	}
+	return ""
+}
+
+func (x *WorkflowProgress) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *WorkflowProgress) GetCompletedSteps() []*StepCompletion {
+	if x != nil {
+		return x.CompletedSteps
+	}
+	return nil
+}
+
+func (x *WorkflowProgress) GetNextStep() string {
+	if x != nil {
+		return x.NextStep
+	}
+	return ""
+}
+
+func (x *WorkflowProgress) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+// Message representing the completion of a workflow step.
+type StepCompletion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Step          string                 `protobuf:"bytes,1,opt,name=step,proto3" json:"step,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=completedAt,proto3" json:"completedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepCompletion) Reset() {
+	*x = StepCompletion{}
+	mi := &file_homework_microservice_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepCompletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepCompletion) ProtoMessage() {}
+
+func (x *StepCompletion) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepCompletion.ProtoReflect.Descriptor instead.
+func (*StepCompletion) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *StepCompletion) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+func (x *StepCompletion) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// Message representing a rubric: the criteria a homework is graded by.
+// A rubric-graded score is the sum of the selected levels' points, out of the sum
+// of each criterion's highest level.
+type Rubric struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Criteria      []*RubricCriterion     `protobuf:"bytes,1,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rubric) Reset() {
+	*x = Rubric{}
+	mi := &file_homework_microservice_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rubric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rubric) ProtoMessage() {}
+
+func (x *Rubric) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rubric.ProtoReflect.Descriptor instead.
+func (*Rubric) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Rubric) GetCriteria() []*RubricCriterion {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+// Message representing a single rubric criterion and its possible levels.
+type RubricCriterion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique within the rubric.
+	Id            string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string         `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Levels        []*RubricLevel `protobuf:"bytes,4,rep,name=levels,proto3" json:"levels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricCriterion) Reset() {
+	*x = RubricCriterion{}
+	mi := &file_homework_microservice_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricCriterion) ProtoMessage() {}
+
+func (x *RubricCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricCriterion.ProtoReflect.Descriptor instead.
+func (*RubricCriterion) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RubricCriterion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RubricCriterion) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RubricCriterion) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-// Response message containing all submissions of a specific student.
-type GetStudentSubmissionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Submissions   []*Submission          `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+func (x *RubricCriterion) GetLevels() []*RubricLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+// Message representing one level of a rubric criterion.
+type RubricLevel struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique within the criterion.
+	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string  `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Points        float64 `protobuf:"fixed64,4,opt,name=points,proto3" json:"points,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStudentSubmissionsResponse) Reset() {
-	*x = GetStudentSubmissionsResponse{}
-	mi := &file_homework_microservice_proto_msgTypes[13]
+func (x *RubricLevel) Reset() {
+	*x = RubricLevel{}
+	mi := &file_homework_microservice_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentSubmissionsResponse) String() string {
+func (x *RubricLevel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentSubmissionsResponse) ProtoMessage() {}
+func (*RubricLevel) ProtoMessage() {}
 
-func (x *GetStudentSubmissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[13]
+func (x *RubricLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,37 +3553,156 @@ func (x *GetStudentSubmissionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentSubmissionsResponse.ProtoReflect.Descriptor instead.
-func (*GetStudentSubmissionsResponse) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RubricLevel.ProtoReflect.Descriptor instead.
+func (*RubricLevel) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetStudentSubmissionsResponse) GetSubmissions() []*Submission {
+func (x *RubricLevel) GetId() string {
 	if x != nil {
-		return x.Submissions
+		return x.Id
 	}
-	return nil
+	return ""
+}
+
+func (x *RubricLevel) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RubricLevel) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RubricLevel) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+// Message representing the level a grader selected for a rubric criterion.
+type CriterionSelection struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CriterionId string                 `protobuf:"bytes,1,opt,name=criterionId,proto3" json:"criterionId,omitempty"`
+	LevelId     string                 `protobuf:"bytes,2,opt,name=levelId,proto3" json:"levelId,omitempty"`
+	Comment     string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Read-only; the points of the selected level.
+	Points        float64 `protobuf:"fixed64,4,opt,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CriterionSelection) Reset() {
+	*x = CriterionSelection{}
+	mi := &file_homework_microservice_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriterionSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriterionSelection) ProtoMessage() {}
+
+func (x *CriterionSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriterionSelection.ProtoReflect.Descriptor instead.
+func (*CriterionSelection) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CriterionSelection) GetCriterionId() string {
+	if x != nil {
+		return x.CriterionId
+	}
+	return ""
+}
+
+func (x *CriterionSelection) GetLevelId() string {
+	if x != nil {
+		return x.LevelId
+	}
+	return ""
+}
+
+func (x *CriterionSelection) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CriterionSelection) GetPoints() float64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
 }
 
 // Message representing Homework details.
 type Homework struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	CourseId      string                 `protobuf:"bytes,3,opt,name=courseId,proto3" json:"courseId,omitempty"`
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Files         []*File                `protobuf:"bytes,6,rep,name=files,proto3" json:"files,omitempty"`
-	Workflow      string                 `protobuf:"bytes,7,opt,name=workflow,proto3" json:"workflow,omitempty"`
-	DueDate       string                 `protobuf:"bytes,8,opt,name=dueDate,proto3" json:"dueDate,omitempty"`
-	Submissions   []*Submission          `protobuf:"bytes,9,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: unused; the caller is authenticated from the request metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token       string  `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id          string  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId    string  `protobuf:"bytes,3,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	Title       string  `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description string  `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Files       []*File `protobuf:"bytes,6,rep,name=files,proto3" json:"files,omitempty"`
+	// Deprecated: free-form text that is stored as-is and not enforced; use workflowDefinition.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Workflow string `protobuf:"bytes,7,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// Deprecated: use dueDate. Accepted as an RFC 3339 timestamp or a YYYY-MM-DD date
+	// when dueDate is unset, and filled in on responses for older clients.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	DueDateText string `protobuf:"bytes,8,opt,name=dueDateText,proto3" json:"dueDateText,omitempty"`
+	// Read-only; the final submissions, added through SubmitHomework. Students only get their
+	// own and their group's.
+	Submissions []*Submission          `protobuf:"bytes,9,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=dueDate,proto3" json:"dueDate,omitempty"`
+	// Read-only; set by the server when the homework is created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// How late submissions are treated; unset means they are accepted without penalty.
+	LatePolicy *LatePolicy `protobuf:"bytes,12,opt,name=latePolicy,proto3" json:"latePolicy,omitempty"`
+	// Maximum number of times a student or group may submit; 0 means unlimited.
+	MaxAttempts int32 `protobuf:"varint,13,opt,name=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	// Maximum number of students in a group, the submitter included; 0 means unlimited.
+	MaxGroupSize int32 `protobuf:"varint,14,opt,name=maxGroupSize,proto3" json:"maxGroupSize,omitempty"`
+	// The rubric submissions are graded by; unset means they are graded with a plain score.
+	Rubric *Rubric `protobuf:"bytes,15,opt,name=rubric,proto3" json:"rubric,omitempty"`
+	// The ordered steps students complete through AdvanceWorkflow; unset means no workflow.
+	WorkflowDefinition *Workflow `protobuf:"bytes,16,opt,name=workflowDefinition,proto3" json:"workflowDefinition,omitempty"`
+	// Read-only on creation; starts at 1 and increases with every update. UpdateHomework must
+	// send the version it last read and fails with ABORTED when the homework changed since.
+	Version       int32 `protobuf:"varint,17,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Homework) Reset() {
 	*x = Homework{}
-	mi := &file_homework_microservice_proto_msgTypes[14]
+	mi := &file_homework_microservice_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -736,7 +3714,7 @@ func (x *Homework) String() string {
 func (*Homework) ProtoMessage() {}
 
 func (x *Homework) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[14]
+	mi := &file_homework_microservice_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,9 +3727,10 @@ func (x *Homework) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Homework.ProtoReflect.Descriptor instead.
 func (*Homework) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{14}
+	return file_homework_microservice_proto_rawDescGZIP(), []int{56}
 }
 
+// Deprecated: Marked as deprecated in homework-microservice.proto.
 func (x *Homework) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -794,6 +3773,7 @@ func (x *Homework) GetFiles() []*File {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in homework-microservice.proto.
 func (x *Homework) GetWorkflow() string {
 	if x != nil {
 		return x.Workflow
@@ -801,9 +3781,10 @@ func (x *Homework) GetWorkflow() string {
 	return ""
 }
 
-func (x *Homework) GetDueDate() string {
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *Homework) GetDueDateText() string {
 	if x != nil {
-		return x.DueDate
+		return x.DueDateText
 	}
 	return ""
 }
@@ -815,20 +3796,160 @@ func (x *Homework) GetSubmissions() []*Submission {
 	return nil
 }
 
+func (x *Homework) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Homework) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Homework) GetLatePolicy() *LatePolicy {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return nil
+}
+
+func (x *Homework) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Homework) GetMaxGroupSize() int32 {
+	if x != nil {
+		return x.MaxGroupSize
+	}
+	return 0
+}
+
+func (x *Homework) GetRubric() *Rubric {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *Homework) GetWorkflowDefinition() *Workflow {
+	if x != nil {
+		return x.WorkflowDefinition
+	}
+	return nil
+}
+
+func (x *Homework) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Message describing how late submissions to a homework are treated.
+type LatePolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Submissions within this period after the due date are not penalized.
+	GracePeriod *durationpb.Duration `protobuf:"bytes,1,opt,name=gracePeriod,proto3" json:"gracePeriod,omitempty"`
+	// Percentage of the score deducted for every started day after the grace period.
+	PenaltyPerDay float64 `protobuf:"fixed64,2,opt,name=penaltyPerDay,proto3" json:"penaltyPerDay,omitempty"`
+	// Upper bound of the total penalty percentage; 0 means the penalty may reach 100.
+	MaxPenalty float64 `protobuf:"fixed64,3,opt,name=maxPenalty,proto3" json:"maxPenalty,omitempty"`
+	// Submissions made later than this after the due date are rejected; unset means never.
+	HardCutoff    *durationpb.Duration `protobuf:"bytes,4,opt,name=hardCutoff,proto3" json:"hardCutoff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatePolicy) Reset() {
+	*x = LatePolicy{}
+	mi := &file_homework_microservice_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatePolicy) ProtoMessage() {}
+
+func (x *LatePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_homework_microservice_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatePolicy.ProtoReflect.Descriptor instead.
+func (*LatePolicy) Descriptor() ([]byte, []int) {
+	return file_homework_microservice_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *LatePolicy) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+func (x *LatePolicy) GetPenaltyPerDay() float64 {
+	if x != nil {
+		return x.PenaltyPerDay
+	}
+	return 0
+}
+
+func (x *LatePolicy) GetMaxPenalty() float64 {
+	if x != nil {
+		return x.MaxPenalty
+	}
+	return 0
+}
+
+func (x *LatePolicy) GetHardCutoff() *durationpb.Duration {
+	if x != nil {
+		return x.HardCutoff
+	}
+	return nil
+}
+
 // Message representing a File.
+// The content is kept in the blob store; records only hold its reference, hash, size and type.
 type File struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	MimeType      string                 `protobuf:"bytes,4,opt,name=mimeType,proto3" json:"mimeType,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: unused; the caller is authenticated from the request metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token    string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Inline content, set by clients to upload a new file.
+	Content  []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	MimeType string `protobuf:"bytes,4,opt,name=mimeType,proto3" json:"mimeType,omitempty"`
+	// Reference to the stored content, assigned by the server.
+	ContentRef string `protobuf:"bytes,5,opt,name=contentRef,proto3" json:"contentRef,omitempty"`
+	// Hex-encoded SHA-256 digest of the content, computed by the server.
+	Sha256 string `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// Size of the content in bytes, computed by the server.
+	Size          int64 `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *File) Reset() {
 	*x = File{}
-	mi := &file_homework_microservice_proto_msgTypes[15]
+	mi := &file_homework_microservice_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -840,7 +3961,7 @@ func (x *File) String() string {
 func (*File) ProtoMessage() {}
 
 func (x *File) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[15]
+	mi := &file_homework_microservice_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,9 +3974,10 @@ func (x *File) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use File.ProtoReflect.Descriptor instead.
 func (*File) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{15}
+	return file_homework_microservice_proto_rawDescGZIP(), []int{58}
 }
 
+// Deprecated: Marked as deprecated in homework-microservice.proto.
 func (x *File) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -884,18 +4006,43 @@ func (x *File) GetMimeType() string {
 	return ""
 }
 
+func (x *File) GetContentRef() string {
+	if x != nil {
+		return x.ContentRef
+	}
+	return ""
+}
+
+func (x *File) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *File) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
 // Message representing the workflow.
+// Step names must be non-empty and unique.
 type Workflow struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Steps         []string               `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: unused; the caller is authenticated from the request metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token         string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Steps         []string `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Workflow) Reset() {
 	*x = Workflow{}
-	mi := &file_homework_microservice_proto_msgTypes[16]
+	mi := &file_homework_microservice_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -907,7 +4054,7 @@ func (x *Workflow) String() string {
 func (*Workflow) ProtoMessage() {}
 
 func (x *Workflow) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[16]
+	mi := &file_homework_microservice_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -920,9 +4067,10 @@ func (x *Workflow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Workflow.ProtoReflect.Descriptor instead.
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{16}
+	return file_homework_microservice_proto_rawDescGZIP(), []int{59}
 }
 
+// Deprecated: Marked as deprecated in homework-microservice.proto.
 func (x *Workflow) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -939,19 +4087,46 @@ func (x *Workflow) GetSteps() []string {
 
 // Message representing a student Submission.
 type Submission struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	StudentId      string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	SubmissionTime string                 `protobuf:"bytes,3,opt,name=submissionTime,proto3" json:"submissionTime,omitempty"`
-	SubmissionFile *File                  `protobuf:"bytes,4,opt,name=submissionFile,proto3" json:"submissionFile,omitempty"`
-	PartnersId     []string               `protobuf:"bytes,5,rep,name=partnersId,proto3" json:"partnersId,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: unused; the caller is authenticated from the request metadata.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	Token     string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudentId string `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	// Deprecated: use submissionTime. Filled in on responses for older clients.
+	//
+	// Deprecated: Marked as deprecated in homework-microservice.proto.
+	SubmissionTimeText string `protobuf:"bytes,3,opt,name=submissionTimeText,proto3" json:"submissionTimeText,omitempty"`
+	SubmissionFile     *File  `protobuf:"bytes,4,opt,name=submissionFile,proto3" json:"submissionFile,omitempty"`
+	// The other members of the submitter's group. May be left empty once the group exists.
+	PartnersId []string `protobuf:"bytes,5,rep,name=partnersId,proto3" json:"partnersId,omitempty"`
+	// Assigned by the server when the submission is stored.
+	Id         string `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`
+	HomeworkId string `protobuf:"bytes,7,opt,name=homeworkId,proto3" json:"homeworkId,omitempty"`
+	// Read-only; set from the server clock when the submission is stored.
+	SubmissionTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=submissionTime,proto3" json:"submissionTime,omitempty"`
+	// Read-only; whether the submission was made after the due date.
+	IsLate bool `protobuf:"varint,9,opt,name=isLate,proto3" json:"isLate,omitempty"`
+	// Read-only; how long after the due date the submission was made.
+	LateBy *durationpb.Duration `protobuf:"bytes,10,opt,name=lateBy,proto3" json:"lateBy,omitempty"`
+	// Read-only; percentage of the score deducted under the homework's late policy.
+	PenaltyPercent float64 `protobuf:"fixed64,11,opt,name=penaltyPercent,proto3" json:"penaltyPercent,omitempty"`
+	// Read-only; the attempt number, starting at 1 and incremented on every resubmission.
+	Version int32 `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	// Read-only; whether this version counts for grading. The latest version is final
+	// unless another one is selected through SelectFinalSubmission.
+	Final bool `protobuf:"varint,13,opt,name=final,proto3" json:"final,omitempty"`
+	// Read-only; the group the submission was made for, empty for individual submissions.
+	// A group is formed by the first submission declaring partners; its later submissions
+	// share one version history and may be made by any member.
+	GroupId       string `protobuf:"bytes,14,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Submission) Reset() {
 	*x = Submission{}
-	mi := &file_homework_microservice_proto_msgTypes[17]
+	mi := &file_homework_microservice_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -963,7 +4138,7 @@ func (x *Submission) String() string {
 func (*Submission) ProtoMessage() {}
 
 func (x *Submission) ProtoReflect() protoreflect.Message {
-	mi := &file_homework_microservice_proto_msgTypes[17]
+	mi := &file_homework_microservice_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -976,9 +4151,10 @@ func (x *Submission) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Submission.ProtoReflect.Descriptor instead.
 func (*Submission) Descriptor() ([]byte, []int) {
-	return file_homework_microservice_proto_rawDescGZIP(), []int{17}
+	return file_homework_microservice_proto_rawDescGZIP(), []int{60}
 }
 
+// Deprecated: Marked as deprecated in homework-microservice.proto.
 func (x *Submission) GetToken() string {
 	if x != nil {
 		return x.Token
@@ -993,9 +4169,10 @@ func (x *Submission) GetStudentId() string {
 	return ""
 }
 
-func (x *Submission) GetSubmissionTime() string {
+// Deprecated: Marked as deprecated in homework-microservice.proto.
+func (x *Submission) GetSubmissionTimeText() string {
 	if x != nil {
-		return x.SubmissionTime
+		return x.SubmissionTimeText
 	}
 	return ""
 }
@@ -1014,144 +4191,760 @@ func (x *Submission) GetPartnersId() []string {
 	return nil
 }
 
+func (x *Submission) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Submission) GetHomeworkId() string {
+	if x != nil {
+		return x.HomeworkId
+	}
+	return ""
+}
+
+func (x *Submission) GetSubmissionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmissionTime
+	}
+	return nil
+}
+
+func (x *Submission) GetIsLate() bool {
+	if x != nil {
+		return x.IsLate
+	}
+	return false
+}
+
+func (x *Submission) GetLateBy() *durationpb.Duration {
+	if x != nil {
+		return x.LateBy
+	}
+	return nil
+}
+
+func (x *Submission) GetPenaltyPercent() float64 {
+	if x != nil {
+		return x.PenaltyPercent
+	}
+	return 0
+}
+
+func (x *Submission) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Submission) GetFinal() bool {
+	if x != nil {
+		return x.Final
+	}
+	return false
+}
+
+func (x *Submission) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
 var File_homework_microservice_proto protoreflect.FileDescriptor
 
 var file_homework_microservice_proto_rawDesc = []byte{
 	0x0a, 0x1b, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x48,
-	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x3a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x6f,
-	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f,
-	0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x02, 0x68, 0x77,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
-	0x6b, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x02, 0x68, 0x77, 0x22, 0x5d,
-	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a,
-	0x08, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77,
-	0x6f, 0x72, 0x6b, 0x52, 0x08, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x3c, 0x0a,
-	0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x02, 0x68, 0x77, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x48,
-	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x02, 0x68, 0x77, 0x22, 0x5d, 0x0a, 0x15, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x08, 0x68, 0x6f,
-	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x48,
-	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
-	0x52, 0x08, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x3c, 0x0a, 0x16, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x02, 0x68, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x48, 0x6f, 0x6d, 0x65,
-	0x77, 0x6f, 0x72, 0x6b, 0x52, 0x02, 0x68, 0x77, 0x22, 0x3d, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x73, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77,
-	0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x34, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x34, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
-	0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77,
-	0x6f, 0x72, 0x6b, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x36, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x52, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x1d, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x66, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x22, 0x39, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x02, 0x68, 0x77, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x02, 0x68, 0x77, 0x22, 0xf1, 0x02,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x08,
+	0x64, 0x75, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x75, 0x65, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x75, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x75, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73,
+	0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x6f, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x68, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x52, 0x09, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x61, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x08, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x08, 0x68, 0x6f, 0x6d,
+	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x3c, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48,
+	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x22, 0x0a, 0x02, 0x68, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x48, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52,
+	0x02, 0x68, 0x77, 0x22, 0x9d, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x0a, 0x08, 0x68, 0x6f, 0x6d, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x48, 0x6f, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x08, 0x68,
+	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x73, 0x6b, 0x22, 0x3c, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d,
+	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
+	0x02, 0x68, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x48, 0x6f, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x02, 0x68,
+	0x77, 0x22, 0x41, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77,
+	0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x34, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x22, 0x50, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
 	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b,
 	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x14, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x53, 0x75, 0x62,
 	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x98, 0x02, 0x0a, 0x08, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
-	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x77, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x58, 0x0a,
+	0x1e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5c, 0x0a, 0x1c, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x1d, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x48, 0x6f, 0x6d,
+	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7e, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x57, 0x0a, 0x1d,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x48, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x84, 0x01, 0x0a, 0x10,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x38, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x87, 0x01, 0x0a,
+	0x13, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x68, 0x0a, 0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
+	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x48,
+	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x22, 0xbf, 0x01, 0x0a, 0x15, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f,
+	0x72, 0x6b, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x16, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x72, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x51, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x09, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x34,
+	0x0a, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdb, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x3c, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x48, 0x6f, 0x6d,
+	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x39, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22,
+	0x4c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x22, 0x3c, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x43,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x48, 0x6f, 0x6d,
+	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x22, 0xc9, 0x03, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x36, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x99, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75,
+	0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x16, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x07, 0x72, 0x65, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x0d, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x64, 0x6a, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x6c,
+	0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x48, 0x6f, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x07, 0x72, 0x65,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x4f, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0x49, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x72, 0x65, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x48, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x08,
+	0x72, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0xe3, 0x03, 0x0a, 0x07, 0x52, 0x65, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a,
+	0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x48,
+	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x0d, 0x61,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x41, 0x74, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x84,
+	0x01, 0x0a, 0x16, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x74, 0x65, 0x70, 0x22, 0x51, 0x0a, 0x17, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x74, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x55,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x74, 0x65, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x53, 0x74, 0x65, 0x70,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65,
+	0x78, 0x74, 0x53, 0x74, 0x65, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65,
+	0x78, 0x74, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x22, 0x62, 0x0a, 0x0e, 0x53, 0x74,
+	0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x74, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70,
+	0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3f,
+	0x0a, 0x06, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x48, 0x6f, 0x6d,
+	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74,
+	0x65, 0x72, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x22,
+	0x88, 0x01, 0x0a, 0x0f, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x48, 0x6f, 0x6d,
-	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65,
-	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x18, 0x0a,
-	0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x48,
-	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x6e, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
-	0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
-	0x36, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75,
-	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x36, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x46, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x48, 0x6f, 0x6d,
-	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61,
-	0x72, 0x74, 0x6e, 0x65, 0x72, 0x73, 0x49, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x73, 0x49, 0x64, 0x32, 0xdc, 0x02, 0x0a, 0x0f, 0x48,
-	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1c, 0x2e,
-	0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6d, 0x65,
-	0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x48, 0x6f,
-	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f,
-	0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1f, 0x2e, 0x48,
-	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f,
-	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48,
-	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x53, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
-	0x6b, 0x12, 0x1f, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f,
-	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1f, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
-	0x6b, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x06, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x48, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x22, 0x6d, 0x0a, 0x0b, 0x52, 0x75,
+	0x62, 0x72, 0x69, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x43, 0x72,
+	0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xa0,
+	0x05, 0x0a, 0x08, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x18, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x05, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x1e, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12,
+	0x24, 0x0a, 0x0b, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x54, 0x65, 0x78, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x48, 0x6f, 0x6d,
+	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a,
+	0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34, 0x0a,
+	0x0a, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x4c, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x48, 0x6f, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x52, 0x75, 0x62, 0x72, 0x69, 0x63, 0x52, 0x06, 0x72, 0x75, 0x62,
+	0x72, 0x69, 0x63, 0x12, 0x42, 0x0a, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x52, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xca, 0x01, 0x0a, 0x0a, 0x4c, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x3b, 0x0a, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x65, 0x72,
+	0x44, 0x61, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x68, 0x61, 0x72, 0x64, 0x43, 0x75, 0x74, 0x6f, 0x66,
+	0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x68, 0x61, 0x72, 0x64, 0x43, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x22, 0xbe,
+	0x01, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0x3a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x22, 0x81, 0x04, 0x0a, 0x0a,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x32, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x54, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x12, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x36, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x0e,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x73, 0x49, 0x64, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x73, 0x49, 0x64, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x42,
+	0x0a, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x4c, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x4c, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x6c, 0x61,
+	0x74, 0x65, 0x42, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x79, 0x12, 0x26, 0x0a,
+	0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x2a,
+	0x6b, 0x0a, 0x0d, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x1e, 0x0a, 0x1a, 0x48, 0x4f, 0x4d, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1b, 0x0a, 0x17, 0x48, 0x4f, 0x4d, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x44, 0x55, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a,
+	0x19, 0x48, 0x4f, 0x4d, 0x45, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x02, 0x2a, 0x82, 0x01, 0x0a,
+	0x0d, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e,
+	0x0a, 0x1a, 0x52, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17,
+	0x0a, 0x13, 0x52, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x47, 0x52, 0x41,
+	0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10,
+	0x03, 0x32, 0xdc, 0x0f, 0x0a, 0x0f, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1c, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x47, 0x65, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x47, 0x65,
+	0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x50, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x12, 0x1e, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d,
+	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1f, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1f, 0x2e, 0x48, 0x6f, 0x6d,
+	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x48, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6d,
+	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x12,
+	0x1f, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x6f, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1f, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x48, 0x6f, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x48, 0x6f, 0x6d,
+	0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64,
+	0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x27, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x48, 0x6f, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x15, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x48,
+	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a,
+	0x0a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x48, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x4f, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x48, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x48,
+	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x0f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x48, 0x6f, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x53,
+	0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x19, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x53, 0x65,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x48, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65,
+	0x6e, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x48, 0x6f,
+	0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x12, 0x1f, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52,
+	0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1f, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
+	0x6b, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f,
-	0x72, 0x6b, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x65, 0x74, 0x74, 0x65, 0x72, 0x47, 0x52,
-	0x2f, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6b, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x21, 0x2e,
+	0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65,
+	0x6e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x20, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f,
+	0x72, 0x6b, 0x2e, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x48, 0x6f, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x24, 0x2e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x48, 0x6f, 0x6d, 0x65,
+	0x77, 0x6f, 0x72, 0x6b, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x47, 0x52, 0x2f, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b,
+	0x2d, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1166,53 +4959,185 @@ func file_homework_microservice_proto_rawDescGZIP() []byte {
 	return file_homework_microservice_proto_rawDescData
 }
 
-var file_homework_microservice_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_homework_microservice_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_homework_microservice_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
 var file_homework_microservice_proto_goTypes = []any{
-	(*GetHomeworkRequest)(nil),            // 0: Homework.GetHomeworkRequest
-	(*GetHomeworkResponse)(nil),           // 1: Homework.GetHomeworkResponse
-	(*CreateHomeworkRequest)(nil),         // 2: Homework.CreateHomeworkRequest
-	(*CreateHomeworkResponse)(nil),        // 3: Homework.CreateHomeworkResponse
-	(*UpdateHomeworkRequest)(nil),         // 4: Homework.UpdateHomeworkRequest
-	(*UpdateHomeworkResponse)(nil),        // 5: Homework.UpdateHomeworkResponse
-	(*DeleteHomeworkRequest)(nil),         // 6: Homework.DeleteHomeworkRequest
-	(*DeleteHomeworkResponse)(nil),        // 7: Homework.DeleteHomeworkResponse
-	(*SubmitHomeworkRequest)(nil),         // 8: Homework.SubmitHomeworkRequest
-	(*SubmitHomeworkResponse)(nil),        // 9: Homework.SubmitHomeworkResponse
-	(*GetSubmissionsRequest)(nil),         // 10: Homework.GetSubmissionsRequest
-	(*GetSubmissionsResponse)(nil),        // 11: Homework.GetSubmissionsResponse
-	(*GetStudentSubmissionsRequest)(nil),  // 12: Homework.GetStudentSubmissionsRequest
-	(*GetStudentSubmissionsResponse)(nil), // 13: Homework.GetStudentSubmissionsResponse
-	(*Homework)(nil),                      // 14: Homework.Homework
-	(*File)(nil),                          // 15: Homework.File
-	(*Workflow)(nil),                      // 16: Homework.Workflow
-	(*Submission)(nil),                    // 17: Homework.Submission
+	(HomeworkOrder)(0),                     // 0: Homework.HomeworkOrder
+	(RegradeStatus)(0),                     // 1: Homework.RegradeStatus
+	(*GetHomeworkRequest)(nil),             // 2: Homework.GetHomeworkRequest
+	(*GetHomeworkResponse)(nil),            // 3: Homework.GetHomeworkResponse
+	(*ListHomeworksRequest)(nil),           // 4: Homework.ListHomeworksRequest
+	(*ListHomeworksResponse)(nil),          // 5: Homework.ListHomeworksResponse
+	(*CreateHomeworkRequest)(nil),          // 6: Homework.CreateHomeworkRequest
+	(*CreateHomeworkResponse)(nil),         // 7: Homework.CreateHomeworkResponse
+	(*UpdateHomeworkRequest)(nil),          // 8: Homework.UpdateHomeworkRequest
+	(*UpdateHomeworkResponse)(nil),         // 9: Homework.UpdateHomeworkResponse
+	(*DeleteHomeworkRequest)(nil),          // 10: Homework.DeleteHomeworkRequest
+	(*DeleteHomeworkResponse)(nil),         // 11: Homework.DeleteHomeworkResponse
+	(*SubmitHomeworkRequest)(nil),          // 12: Homework.SubmitHomeworkRequest
+	(*SubmitHomeworkResponse)(nil),         // 13: Homework.SubmitHomeworkResponse
+	(*GetSubmissionsRequest)(nil),          // 14: Homework.GetSubmissionsRequest
+	(*GetSubmissionsResponse)(nil),         // 15: Homework.GetSubmissionsResponse
+	(*ListSubmissionVersionsRequest)(nil),  // 16: Homework.ListSubmissionVersionsRequest
+	(*ListSubmissionVersionsResponse)(nil), // 17: Homework.ListSubmissionVersionsResponse
+	(*SelectFinalSubmissionRequest)(nil),   // 18: Homework.SelectFinalSubmissionRequest
+	(*SelectFinalSubmissionResponse)(nil),  // 19: Homework.SelectFinalSubmissionResponse
+	(*GetStudentSubmissionsRequest)(nil),   // 20: Homework.GetStudentSubmissionsRequest
+	(*GetStudentSubmissionsResponse)(nil),  // 21: Homework.GetStudentSubmissionsResponse
+	(*UploadFileRequest)(nil),              // 22: Homework.UploadFileRequest
+	(*UploadFileHeader)(nil),               // 23: Homework.UploadFileHeader
+	(*UploadFileResponse)(nil),             // 24: Homework.UploadFileResponse
+	(*DownloadFileRequest)(nil),            // 25: Homework.DownloadFileRequest
+	(*DownloadFileResponse)(nil),           // 26: Homework.DownloadFileResponse
+	(*GrantExtensionRequest)(nil),          // 27: Homework.GrantExtensionRequest
+	(*GrantExtensionResponse)(nil),         // 28: Homework.GrantExtensionResponse
+	(*RevokeExtensionRequest)(nil),         // 29: Homework.RevokeExtensionRequest
+	(*RevokeExtensionResponse)(nil),        // 30: Homework.RevokeExtensionResponse
+	(*ListExtensionsRequest)(nil),          // 31: Homework.ListExtensionsRequest
+	(*ListExtensionsResponse)(nil),         // 32: Homework.ListExtensionsResponse
+	(*Extension)(nil),                      // 33: Homework.Extension
+	(*SetGradeRequest)(nil),                // 34: Homework.SetGradeRequest
+	(*SetGradeResponse)(nil),               // 35: Homework.SetGradeResponse
+	(*GetGradesRequest)(nil),               // 36: Homework.GetGradesRequest
+	(*GetGradesResponse)(nil),              // 37: Homework.GetGradesResponse
+	(*GetStudentGradesRequest)(nil),        // 38: Homework.GetStudentGradesRequest
+	(*GetStudentGradesResponse)(nil),       // 39: Homework.GetStudentGradesResponse
+	(*Grade)(nil),                          // 40: Homework.Grade
+	(*RequestRegradeRequest)(nil),          // 41: Homework.RequestRegradeRequest
+	(*RequestRegradeResponse)(nil),         // 42: Homework.RequestRegradeResponse
+	(*ResolveRegradeRequest)(nil),          // 43: Homework.ResolveRegradeRequest
+	(*ResolveRegradeResponse)(nil),         // 44: Homework.ResolveRegradeResponse
+	(*ListOpenRegradesRequest)(nil),        // 45: Homework.ListOpenRegradesRequest
+	(*ListOpenRegradesResponse)(nil),       // 46: Homework.ListOpenRegradesResponse
+	(*Regrade)(nil),                        // 47: Homework.Regrade
+	(*AdvanceWorkflowRequest)(nil),         // 48: Homework.AdvanceWorkflowRequest
+	(*AdvanceWorkflowResponse)(nil),        // 49: Homework.AdvanceWorkflowResponse
+	(*GetWorkflowProgressRequest)(nil),     // 50: Homework.GetWorkflowProgressRequest
+	(*GetWorkflowProgressResponse)(nil),    // 51: Homework.GetWorkflowProgressResponse
+	(*WorkflowProgress)(nil),               // 52: Homework.WorkflowProgress
+	(*StepCompletion)(nil),                 // 53: Homework.StepCompletion
+	(*Rubric)(nil),                         // 54: Homework.Rubric
+	(*RubricCriterion)(nil),                // 55: Homework.RubricCriterion
+	(*RubricLevel)(nil),                    // 56: Homework.RubricLevel
+	(*CriterionSelection)(nil),             // 57: Homework.CriterionSelection
+	(*Homework)(nil),                       // 58: Homework.Homework
+	(*LatePolicy)(nil),                     // 59: Homework.LatePolicy
+	(*File)(nil),                           // 60: Homework.File
+	(*Workflow)(nil),                       // 61: Homework.Workflow
+	(*Submission)(nil),                     // 62: Homework.Submission
+	(*timestamppb.Timestamp)(nil),          // 63: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),          // 64: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),            // 65: google.protobuf.Duration
 }
 var file_homework_microservice_proto_depIdxs = []int32{
-	14, // 0: Homework.GetHomeworkResponse.hw:type_name -> Homework.Homework
-	14, // 1: Homework.CreateHomeworkRequest.homework:type_name -> Homework.Homework
-	14, // 2: Homework.CreateHomeworkResponse.hw:type_name -> Homework.Homework
-	14, // 3: Homework.UpdateHomeworkRequest.homework:type_name -> Homework.Homework
-	14, // 4: Homework.UpdateHomeworkResponse.hw:type_name -> Homework.Homework
-	17, // 5: Homework.SubmitHomeworkRequest.submission:type_name -> Homework.Submission
-	17, // 6: Homework.SubmitHomeworkResponse.submission:type_name -> Homework.Submission
-	17, // 7: Homework.GetSubmissionsResponse.submissions:type_name -> Homework.Submission
-	17, // 8: Homework.GetStudentSubmissionsResponse.submissions:type_name -> Homework.Submission
-	15, // 9: Homework.Homework.files:type_name -> Homework.File
-	17, // 10: Homework.Homework.submissions:type_name -> Homework.Submission
-	15, // 11: Homework.Submission.submissionFile:type_name -> Homework.File
-	0,  // 12: Homework.HomeworkService.GetHomework:input_type -> Homework.GetHomeworkRequest
-	2,  // 13: Homework.HomeworkService.CreateHomework:input_type -> Homework.CreateHomeworkRequest
-	4,  // 14: Homework.HomeworkService.UpdateHomework:input_type -> Homework.UpdateHomeworkRequest
-	6,  // 15: Homework.HomeworkService.DeleteHomework:input_type -> Homework.DeleteHomeworkRequest
-	1,  // 16: Homework.HomeworkService.GetHomework:output_type -> Homework.GetHomeworkResponse
-	3,  // 17: Homework.HomeworkService.CreateHomework:output_type -> Homework.CreateHomeworkResponse
-	5,  // 18: Homework.HomeworkService.UpdateHomework:output_type -> Homework.UpdateHomeworkResponse
-	7,  // 19: Homework.HomeworkService.DeleteHomework:output_type -> Homework.DeleteHomeworkResponse
-	16, // [16:20] is the sub-list for method output_type
-	12, // [12:16] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	58, // 0: Homework.GetHomeworkResponse.hw:type_name -> Homework.Homework
+	63, // 1: Homework.ListHomeworksRequest.dueAfter:type_name -> google.protobuf.Timestamp
+	63, // 2: Homework.ListHomeworksRequest.dueBefore:type_name -> google.protobuf.Timestamp
+	0,  // 3: Homework.ListHomeworksRequest.orderBy:type_name -> Homework.HomeworkOrder
+	58, // 4: Homework.ListHomeworksResponse.homeworks:type_name -> Homework.Homework
+	58, // 5: Homework.CreateHomeworkRequest.homework:type_name -> Homework.Homework
+	58, // 6: Homework.CreateHomeworkResponse.hw:type_name -> Homework.Homework
+	58, // 7: Homework.UpdateHomeworkRequest.homework:type_name -> Homework.Homework
+	64, // 8: Homework.UpdateHomeworkRequest.updateMask:type_name -> google.protobuf.FieldMask
+	58, // 9: Homework.UpdateHomeworkResponse.hw:type_name -> Homework.Homework
+	62, // 10: Homework.SubmitHomeworkRequest.submission:type_name -> Homework.Submission
+	62, // 11: Homework.SubmitHomeworkResponse.submission:type_name -> Homework.Submission
+	62, // 12: Homework.GetSubmissionsResponse.submissions:type_name -> Homework.Submission
+	62, // 13: Homework.ListSubmissionVersionsResponse.submissions:type_name -> Homework.Submission
+	62, // 14: Homework.SelectFinalSubmissionResponse.submission:type_name -> Homework.Submission
+	62, // 15: Homework.GetStudentSubmissionsResponse.submissions:type_name -> Homework.Submission
+	23, // 16: Homework.UploadFileRequest.header:type_name -> Homework.UploadFileHeader
+	60, // 17: Homework.UploadFileResponse.file:type_name -> Homework.File
+	60, // 18: Homework.DownloadFileResponse.file:type_name -> Homework.File
+	63, // 19: Homework.GrantExtensionRequest.dueDate:type_name -> google.protobuf.Timestamp
+	33, // 20: Homework.GrantExtensionResponse.extensions:type_name -> Homework.Extension
+	33, // 21: Homework.ListExtensionsResponse.extensions:type_name -> Homework.Extension
+	63, // 22: Homework.Extension.dueDate:type_name -> google.protobuf.Timestamp
+	63, // 23: Homework.Extension.grantedAt:type_name -> google.protobuf.Timestamp
+	57, // 24: Homework.SetGradeRequest.selections:type_name -> Homework.CriterionSelection
+	40, // 25: Homework.SetGradeResponse.grade:type_name -> Homework.Grade
+	40, // 26: Homework.GetGradesResponse.grades:type_name -> Homework.Grade
+	40, // 27: Homework.GetStudentGradesResponse.grades:type_name -> Homework.Grade
+	63, // 28: Homework.Grade.gradedAt:type_name -> google.protobuf.Timestamp
+	63, // 29: Homework.Grade.updatedAt:type_name -> google.protobuf.Timestamp
+	57, // 30: Homework.Grade.selections:type_name -> Homework.CriterionSelection
+	47, // 31: Homework.RequestRegradeResponse.regrade:type_name -> Homework.Regrade
+	1,  // 32: Homework.ResolveRegradeRequest.status:type_name -> Homework.RegradeStatus
+	47, // 33: Homework.ResolveRegradeResponse.regrade:type_name -> Homework.Regrade
+	40, // 34: Homework.ResolveRegradeResponse.grade:type_name -> Homework.Grade
+	47, // 35: Homework.ListOpenRegradesResponse.regrades:type_name -> Homework.Regrade
+	1,  // 36: Homework.Regrade.status:type_name -> Homework.RegradeStatus
+	63, // 37: Homework.Regrade.createdAt:type_name -> google.protobuf.Timestamp
+	63, // 38: Homework.Regrade.resolvedAt:type_name -> google.protobuf.Timestamp
+	52, // 39: Homework.AdvanceWorkflowResponse.progress:type_name -> Homework.WorkflowProgress
+	52, // 40: Homework.GetWorkflowProgressResponse.progress:type_name -> Homework.WorkflowProgress
+	53, // 41: Homework.WorkflowProgress.completedSteps:type_name -> Homework.StepCompletion
+	63, // 42: Homework.StepCompletion.completedAt:type_name -> google.protobuf.Timestamp
+	55, // 43: Homework.Rubric.criteria:type_name -> Homework.RubricCriterion
+	56, // 44: Homework.RubricCriterion.levels:type_name -> Homework.RubricLevel
+	60, // 45: Homework.Homework.files:type_name -> Homework.File
+	62, // 46: Homework.Homework.submissions:type_name -> Homework.Submission
+	63, // 47: Homework.Homework.dueDate:type_name -> google.protobuf.Timestamp
+	63, // 48: Homework.Homework.createdAt:type_name -> google.protobuf.Timestamp
+	59, // 49: Homework.Homework.latePolicy:type_name -> Homework.LatePolicy
+	54, // 50: Homework.Homework.rubric:type_name -> Homework.Rubric
+	61, // 51: Homework.Homework.workflowDefinition:type_name -> Homework.Workflow
+	65, // 52: Homework.LatePolicy.gracePeriod:type_name -> google.protobuf.Duration
+	65, // 53: Homework.LatePolicy.hardCutoff:type_name -> google.protobuf.Duration
+	60, // 54: Homework.Submission.submissionFile:type_name -> Homework.File
+	63, // 55: Homework.Submission.submissionTime:type_name -> google.protobuf.Timestamp
+	65, // 56: Homework.Submission.lateBy:type_name -> google.protobuf.Duration
+	2,  // 57: Homework.HomeworkService.GetHomework:input_type -> Homework.GetHomeworkRequest
+	4,  // 58: Homework.HomeworkService.ListHomeworks:input_type -> Homework.ListHomeworksRequest
+	6,  // 59: Homework.HomeworkService.CreateHomework:input_type -> Homework.CreateHomeworkRequest
+	8,  // 60: Homework.HomeworkService.UpdateHomework:input_type -> Homework.UpdateHomeworkRequest
+	10, // 61: Homework.HomeworkService.DeleteHomework:input_type -> Homework.DeleteHomeworkRequest
+	12, // 62: Homework.HomeworkService.SubmitHomework:input_type -> Homework.SubmitHomeworkRequest
+	14, // 63: Homework.HomeworkService.GetSubmissions:input_type -> Homework.GetSubmissionsRequest
+	20, // 64: Homework.HomeworkService.GetStudentSubmissions:input_type -> Homework.GetStudentSubmissionsRequest
+	16, // 65: Homework.HomeworkService.ListSubmissionVersions:input_type -> Homework.ListSubmissionVersionsRequest
+	18, // 66: Homework.HomeworkService.SelectFinalSubmission:input_type -> Homework.SelectFinalSubmissionRequest
+	22, // 67: Homework.HomeworkService.UploadFile:input_type -> Homework.UploadFileRequest
+	25, // 68: Homework.HomeworkService.DownloadFile:input_type -> Homework.DownloadFileRequest
+	27, // 69: Homework.HomeworkService.GrantExtension:input_type -> Homework.GrantExtensionRequest
+	29, // 70: Homework.HomeworkService.RevokeExtension:input_type -> Homework.RevokeExtensionRequest
+	31, // 71: Homework.HomeworkService.ListExtensions:input_type -> Homework.ListExtensionsRequest
+	34, // 72: Homework.HomeworkService.SetGrade:input_type -> Homework.SetGradeRequest
+	36, // 73: Homework.HomeworkService.GetGrades:input_type -> Homework.GetGradesRequest
+	38, // 74: Homework.HomeworkService.GetStudentGrades:input_type -> Homework.GetStudentGradesRequest
+	41, // 75: Homework.HomeworkService.RequestRegrade:input_type -> Homework.RequestRegradeRequest
+	43, // 76: Homework.HomeworkService.ResolveRegrade:input_type -> Homework.ResolveRegradeRequest
+	45, // 77: Homework.HomeworkService.ListOpenRegrades:input_type -> Homework.ListOpenRegradesRequest
+	48, // 78: Homework.HomeworkService.AdvanceWorkflow:input_type -> Homework.AdvanceWorkflowRequest
+	50, // 79: Homework.HomeworkService.GetWorkflowProgress:input_type -> Homework.GetWorkflowProgressRequest
+	3,  // 80: Homework.HomeworkService.GetHomework:output_type -> Homework.GetHomeworkResponse
+	5,  // 81: Homework.HomeworkService.ListHomeworks:output_type -> Homework.ListHomeworksResponse
+	7,  // 82: Homework.HomeworkService.CreateHomework:output_type -> Homework.CreateHomeworkResponse
+	9,  // 83: Homework.HomeworkService.UpdateHomework:output_type -> Homework.UpdateHomeworkResponse
+	11, // 84: Homework.HomeworkService.DeleteHomework:output_type -> Homework.DeleteHomeworkResponse
+	13, // 85: Homework.HomeworkService.SubmitHomework:output_type -> Homework.SubmitHomeworkResponse
+	15, // 86: Homework.HomeworkService.GetSubmissions:output_type -> Homework.GetSubmissionsResponse
+	21, // 87: Homework.HomeworkService.GetStudentSubmissions:output_type -> Homework.GetStudentSubmissionsResponse
+	17, // 88: Homework.HomeworkService.ListSubmissionVersions:output_type -> Homework.ListSubmissionVersionsResponse
+	19, // 89: Homework.HomeworkService.SelectFinalSubmission:output_type -> Homework.SelectFinalSubmissionResponse
+	24, // 90: Homework.HomeworkService.UploadFile:output_type -> Homework.UploadFileResponse
+	26, // 91: Homework.HomeworkService.DownloadFile:output_type -> Homework.DownloadFileResponse
+	28, // 92: Homework.HomeworkService.GrantExtension:output_type -> Homework.GrantExtensionResponse
+	30, // 93: Homework.HomeworkService.RevokeExtension:output_type -> Homework.RevokeExtensionResponse
+	32, // 94: Homework.HomeworkService.ListExtensions:output_type -> Homework.ListExtensionsResponse
+	35, // 95: Homework.HomeworkService.SetGrade:output_type -> Homework.SetGradeResponse
+	37, // 96: Homework.HomeworkService.GetGrades:output_type -> Homework.GetGradesResponse
+	39, // 97: Homework.HomeworkService.GetStudentGrades:output_type -> Homework.GetStudentGradesResponse
+	42, // 98: Homework.HomeworkService.RequestRegrade:output_type -> Homework.RequestRegradeResponse
+	44, // 99: Homework.HomeworkService.ResolveRegrade:output_type -> Homework.ResolveRegradeResponse
+	46, // 100: Homework.HomeworkService.ListOpenRegrades:output_type -> Homework.ListOpenRegradesResponse
+	49, // 101: Homework.HomeworkService.AdvanceWorkflow:output_type -> Homework.AdvanceWorkflowResponse
+	51, // 102: Homework.HomeworkService.GetWorkflowProgress:output_type -> Homework.GetWorkflowProgressResponse
+	80, // [80:103] is the sub-list for method output_type
+	57, // [57:80] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_homework_microservice_proto_init() }
@@ -1220,18 +5145,26 @@ func file_homework_microservice_proto_init() {
 	if File_homework_microservice_proto != nil {
 		return
 	}
+	file_homework_microservice_proto_msgTypes[20].OneofWrappers = []any{
+		(*UploadFileRequest_Header)(nil),
+		(*UploadFileRequest_Chunk)(nil),
+		(*UploadFileRequest_Sha256)(nil),
+	}
+	file_homework_microservice_proto_msgTypes[41].OneofWrappers = []any{}
+	file_homework_microservice_proto_msgTypes[45].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_homework_microservice_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      2,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_homework_microservice_proto_goTypes,
 		DependencyIndexes: file_homework_microservice_proto_depIdxs,
+		EnumInfos:         file_homework_microservice_proto_enumTypes,
 		MessageInfos:      file_homework_microservice_proto_msgTypes,
 	}.Build()
 	File_homework_microservice_proto = out.File
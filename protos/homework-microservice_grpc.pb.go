@@ -19,10 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	HomeworkService_GetHomework_FullMethodName    = "/Homework.HomeworkService/GetHomework"
-	HomeworkService_CreateHomework_FullMethodName = "/Homework.HomeworkService/CreateHomework"
-	HomeworkService_UpdateHomework_FullMethodName = "/Homework.HomeworkService/UpdateHomework"
-	HomeworkService_DeleteHomework_FullMethodName = "/Homework.HomeworkService/DeleteHomework"
+	HomeworkService_GetHomework_FullMethodName            = "/Homework.HomeworkService/GetHomework"
+	HomeworkService_ListHomeworks_FullMethodName          = "/Homework.HomeworkService/ListHomeworks"
+	HomeworkService_CreateHomework_FullMethodName         = "/Homework.HomeworkService/CreateHomework"
+	HomeworkService_UpdateHomework_FullMethodName         = "/Homework.HomeworkService/UpdateHomework"
+	HomeworkService_DeleteHomework_FullMethodName         = "/Homework.HomeworkService/DeleteHomework"
+	HomeworkService_SubmitHomework_FullMethodName         = "/Homework.HomeworkService/SubmitHomework"
+	HomeworkService_GetSubmissions_FullMethodName         = "/Homework.HomeworkService/GetSubmissions"
+	HomeworkService_GetStudentSubmissions_FullMethodName  = "/Homework.HomeworkService/GetStudentSubmissions"
+	HomeworkService_ListSubmissionVersions_FullMethodName = "/Homework.HomeworkService/ListSubmissionVersions"
+	HomeworkService_SelectFinalSubmission_FullMethodName  = "/Homework.HomeworkService/SelectFinalSubmission"
+	HomeworkService_UploadFile_FullMethodName             = "/Homework.HomeworkService/UploadFile"
+	HomeworkService_DownloadFile_FullMethodName           = "/Homework.HomeworkService/DownloadFile"
+	HomeworkService_GrantExtension_FullMethodName         = "/Homework.HomeworkService/GrantExtension"
+	HomeworkService_RevokeExtension_FullMethodName        = "/Homework.HomeworkService/RevokeExtension"
+	HomeworkService_ListExtensions_FullMethodName         = "/Homework.HomeworkService/ListExtensions"
+	HomeworkService_SetGrade_FullMethodName               = "/Homework.HomeworkService/SetGrade"
+	HomeworkService_GetGrades_FullMethodName              = "/Homework.HomeworkService/GetGrades"
+	HomeworkService_GetStudentGrades_FullMethodName       = "/Homework.HomeworkService/GetStudentGrades"
+	HomeworkService_RequestRegrade_FullMethodName         = "/Homework.HomeworkService/RequestRegrade"
+	HomeworkService_ResolveRegrade_FullMethodName         = "/Homework.HomeworkService/ResolveRegrade"
+	HomeworkService_ListOpenRegrades_FullMethodName       = "/Homework.HomeworkService/ListOpenRegrades"
+	HomeworkService_AdvanceWorkflow_FullMethodName        = "/Homework.HomeworkService/AdvanceWorkflow"
+	HomeworkService_GetWorkflowProgress_FullMethodName    = "/Homework.HomeworkService/GetWorkflowProgress"
 )
 
 // HomeworkServiceClient is the client API for HomeworkService service.
@@ -31,15 +50,56 @@ const (
 //
 // The Homework domain manages all information related to assignments for each course,
 // including workflows for completing homework and submission tracking.
+// Every RPC authenticates the caller by the bearer token in the "authorization" metadata.
 type HomeworkServiceClient interface {
 	// Returns homework by Id.
 	GetHomework(ctx context.Context, in *GetHomeworkRequest, opts ...grpc.CallOption) (*GetHomeworkResponse, error)
+	// Lists the homeworks of a course, one page at a time.
+	ListHomeworks(ctx context.Context, in *ListHomeworksRequest, opts ...grpc.CallOption) (*ListHomeworksResponse, error)
 	// Creates a new homework for a certain course.
 	CreateHomework(ctx context.Context, in *CreateHomeworkRequest, opts ...grpc.CallOption) (*CreateHomeworkResponse, error)
 	// Updates a homework for a certain course.
 	UpdateHomework(ctx context.Context, in *UpdateHomeworkRequest, opts ...grpc.CallOption) (*UpdateHomeworkResponse, error)
 	// Deletes a homework for a certain course.
 	DeleteHomework(ctx context.Context, in *DeleteHomeworkRequest, opts ...grpc.CallOption) (*DeleteHomeworkResponse, error)
+	// Submits a homework on behalf of a student.
+	SubmitHomework(ctx context.Context, in *SubmitHomeworkRequest, opts ...grpc.CallOption) (*SubmitHomeworkResponse, error)
+	// Returns the final submissions for a homework; students only get their own and their group's.
+	GetSubmissions(ctx context.Context, in *GetSubmissionsRequest, opts ...grpc.CallOption) (*GetSubmissionsResponse, error)
+	// Returns the final submissions of a specific student, including those of their groups.
+	GetStudentSubmissions(ctx context.Context, in *GetStudentSubmissionsRequest, opts ...grpc.CallOption) (*GetStudentSubmissionsResponse, error)
+	// Returns every submitted version of a student's homework, or of their group's, oldest first.
+	ListSubmissionVersions(ctx context.Context, in *ListSubmissionVersionsRequest, opts ...grpc.CallOption) (*ListSubmissionVersionsResponse, error)
+	// Marks a submitted version as the one that counts for grading.
+	SelectFinalSubmission(ctx context.Context, in *SelectFinalSubmissionRequest, opts ...grpc.CallOption) (*SelectFinalSubmissionResponse, error)
+	// Uploads a file in chunks and returns a reference that homeworks and submissions can attach.
+	UploadFile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadFileRequest, UploadFileResponse], error)
+	// Streams the content of a homework or submission file in chunks.
+	DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadFileResponse], error)
+	// Grants students an individual due date for a homework, replacing earlier extensions.
+	GrantExtension(ctx context.Context, in *GrantExtensionRequest, opts ...grpc.CallOption) (*GrantExtensionResponse, error)
+	// Revokes the extensions of students on a homework.
+	RevokeExtension(ctx context.Context, in *RevokeExtensionRequest, opts ...grpc.CallOption) (*RevokeExtensionResponse, error)
+	// Lists the extensions granted on a homework.
+	ListExtensions(ctx context.Context, in *ListExtensionsRequest, opts ...grpc.CallOption) (*ListExtensionsResponse, error)
+	// Sets the score and feedback of a submission, replacing any previous grade.
+	// Submissions of homeworks with a rubric are graded by selecting a level per criterion.
+	SetGrade(ctx context.Context, in *SetGradeRequest, opts ...grpc.CallOption) (*SetGradeResponse, error)
+	// Returns the grades of the final submissions for a homework.
+	GetGrades(ctx context.Context, in *GetGradesRequest, opts ...grpc.CallOption) (*GetGradesResponse, error)
+	// Returns the grades of a student's final submissions, including those of their groups.
+	GetStudentGrades(ctx context.Context, in *GetStudentGradesRequest, opts ...grpc.CallOption) (*GetStudentGradesResponse, error)
+	// Opens a regrade request on a graded submission.
+	RequestRegrade(ctx context.Context, in *RequestRegradeRequest, opts ...grpc.CallOption) (*RequestRegradeResponse, error)
+	// Accepts or rejects an open regrade request; accepting replaces the score of the grade.
+	ResolveRegrade(ctx context.Context, in *ResolveRegradeRequest, opts ...grpc.CallOption) (*ResolveRegradeResponse, error)
+	// Returns the open regrade requests of a course, oldest first.
+	ListOpenRegrades(ctx context.Context, in *ListOpenRegradesRequest, opts ...grpc.CallOption) (*ListOpenRegradesResponse, error)
+	// Completes the next step of a homework's workflow for a student.
+	// Steps must be completed in order; anything else is rejected.
+	AdvanceWorkflow(ctx context.Context, in *AdvanceWorkflowRequest, opts ...grpc.CallOption) (*AdvanceWorkflowResponse, error)
+	// Returns the workflow progress of one student, or of every student who started it.
+	GetWorkflowProgress(ctx context.Context, in *GetWorkflowProgressRequest, opts ...grpc.CallOption) (*GetWorkflowProgressResponse, error)
 }
 
 type homeworkServiceClient struct {
@@ -60,6 +120,16 @@ func (c *homeworkServiceClient) GetHomework(ctx context.Context, in *GetHomework
 	return out, nil
 }
 
+func (c *homeworkServiceClient) ListHomeworks(ctx context.Context, in *ListHomeworksRequest, opts ...grpc.CallOption) (*ListHomeworksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHomeworksResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_ListHomeworks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *homeworkServiceClient) CreateHomework(ctx context.Context, in *CreateHomeworkRequest, opts ...grpc.CallOption) (*CreateHomeworkResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateHomeworkResponse)
@@ -90,21 +160,254 @@ func (c *homeworkServiceClient) DeleteHomework(ctx context.Context, in *DeleteHo
 	return out, nil
 }
 
+func (c *homeworkServiceClient) SubmitHomework(ctx context.Context, in *SubmitHomeworkRequest, opts ...grpc.CallOption) (*SubmitHomeworkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitHomeworkResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_SubmitHomework_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) GetSubmissions(ctx context.Context, in *GetSubmissionsRequest, opts ...grpc.CallOption) (*GetSubmissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSubmissionsResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_GetSubmissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) GetStudentSubmissions(ctx context.Context, in *GetStudentSubmissionsRequest, opts ...grpc.CallOption) (*GetStudentSubmissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentSubmissionsResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_GetStudentSubmissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) ListSubmissionVersions(ctx context.Context, in *ListSubmissionVersionsRequest, opts ...grpc.CallOption) (*ListSubmissionVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubmissionVersionsResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_ListSubmissionVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) SelectFinalSubmission(ctx context.Context, in *SelectFinalSubmissionRequest, opts ...grpc.CallOption) (*SelectFinalSubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelectFinalSubmissionResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_SelectFinalSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) UploadFile(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadFileRequest, UploadFileResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HomeworkService_ServiceDesc.Streams[0], HomeworkService_UploadFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadFileRequest, UploadFileResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HomeworkService_UploadFileClient = grpc.ClientStreamingClient[UploadFileRequest, UploadFileResponse]
+
+func (c *homeworkServiceClient) DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadFileResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HomeworkService_ServiceDesc.Streams[1], HomeworkService_DownloadFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadFileRequest, DownloadFileResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HomeworkService_DownloadFileClient = grpc.ServerStreamingClient[DownloadFileResponse]
+
+func (c *homeworkServiceClient) GrantExtension(ctx context.Context, in *GrantExtensionRequest, opts ...grpc.CallOption) (*GrantExtensionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantExtensionResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_GrantExtension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) RevokeExtension(ctx context.Context, in *RevokeExtensionRequest, opts ...grpc.CallOption) (*RevokeExtensionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeExtensionResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_RevokeExtension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) ListExtensions(ctx context.Context, in *ListExtensionsRequest, opts ...grpc.CallOption) (*ListExtensionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExtensionsResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_ListExtensions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) SetGrade(ctx context.Context, in *SetGradeRequest, opts ...grpc.CallOption) (*SetGradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGradeResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_SetGrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) GetGrades(ctx context.Context, in *GetGradesRequest, opts ...grpc.CallOption) (*GetGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGradesResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_GetGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) GetStudentGrades(ctx context.Context, in *GetStudentGradesRequest, opts ...grpc.CallOption) (*GetStudentGradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentGradesResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_GetStudentGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) RequestRegrade(ctx context.Context, in *RequestRegradeRequest, opts ...grpc.CallOption) (*RequestRegradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestRegradeResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_RequestRegrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) ResolveRegrade(ctx context.Context, in *ResolveRegradeRequest, opts ...grpc.CallOption) (*ResolveRegradeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveRegradeResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_ResolveRegrade_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) ListOpenRegrades(ctx context.Context, in *ListOpenRegradesRequest, opts ...grpc.CallOption) (*ListOpenRegradesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOpenRegradesResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_ListOpenRegrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) AdvanceWorkflow(ctx context.Context, in *AdvanceWorkflowRequest, opts ...grpc.CallOption) (*AdvanceWorkflowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdvanceWorkflowResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_AdvanceWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *homeworkServiceClient) GetWorkflowProgress(ctx context.Context, in *GetWorkflowProgressRequest, opts ...grpc.CallOption) (*GetWorkflowProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkflowProgressResponse)
+	err := c.cc.Invoke(ctx, HomeworkService_GetWorkflowProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HomeworkServiceServer is the server API for HomeworkService service.
 // All implementations must embed UnimplementedHomeworkServiceServer
 // for forward compatibility.
 //
 // The Homework domain manages all information related to assignments for each course,
 // including workflows for completing homework and submission tracking.
+// Every RPC authenticates the caller by the bearer token in the "authorization" metadata.
 type HomeworkServiceServer interface {
 	// Returns homework by Id.
 	GetHomework(context.Context, *GetHomeworkRequest) (*GetHomeworkResponse, error)
+	// Lists the homeworks of a course, one page at a time.
+	ListHomeworks(context.Context, *ListHomeworksRequest) (*ListHomeworksResponse, error)
 	// Creates a new homework for a certain course.
 	CreateHomework(context.Context, *CreateHomeworkRequest) (*CreateHomeworkResponse, error)
 	// Updates a homework for a certain course.
 	UpdateHomework(context.Context, *UpdateHomeworkRequest) (*UpdateHomeworkResponse, error)
 	// Deletes a homework for a certain course.
 	DeleteHomework(context.Context, *DeleteHomeworkRequest) (*DeleteHomeworkResponse, error)
+	// Submits a homework on behalf of a student.
+	SubmitHomework(context.Context, *SubmitHomeworkRequest) (*SubmitHomeworkResponse, error)
+	// Returns the final submissions for a homework; students only get their own and their group's.
+	GetSubmissions(context.Context, *GetSubmissionsRequest) (*GetSubmissionsResponse, error)
+	// Returns the final submissions of a specific student, including those of their groups.
+	GetStudentSubmissions(context.Context, *GetStudentSubmissionsRequest) (*GetStudentSubmissionsResponse, error)
+	// Returns every submitted version of a student's homework, or of their group's, oldest first.
+	ListSubmissionVersions(context.Context, *ListSubmissionVersionsRequest) (*ListSubmissionVersionsResponse, error)
+	// Marks a submitted version as the one that counts for grading.
+	SelectFinalSubmission(context.Context, *SelectFinalSubmissionRequest) (*SelectFinalSubmissionResponse, error)
+	// Uploads a file in chunks and returns a reference that homeworks and submissions can attach.
+	UploadFile(grpc.ClientStreamingServer[UploadFileRequest, UploadFileResponse]) error
+	// Streams the content of a homework or submission file in chunks.
+	DownloadFile(*DownloadFileRequest, grpc.ServerStreamingServer[DownloadFileResponse]) error
+	// Grants students an individual due date for a homework, replacing earlier extensions.
+	GrantExtension(context.Context, *GrantExtensionRequest) (*GrantExtensionResponse, error)
+	// Revokes the extensions of students on a homework.
+	RevokeExtension(context.Context, *RevokeExtensionRequest) (*RevokeExtensionResponse, error)
+	// Lists the extensions granted on a homework.
+	ListExtensions(context.Context, *ListExtensionsRequest) (*ListExtensionsResponse, error)
+	// Sets the score and feedback of a submission, replacing any previous grade.
+	// Submissions of homeworks with a rubric are graded by selecting a level per criterion.
+	SetGrade(context.Context, *SetGradeRequest) (*SetGradeResponse, error)
+	// Returns the grades of the final submissions for a homework.
+	GetGrades(context.Context, *GetGradesRequest) (*GetGradesResponse, error)
+	// Returns the grades of a student's final submissions, including those of their groups.
+	GetStudentGrades(context.Context, *GetStudentGradesRequest) (*GetStudentGradesResponse, error)
+	// Opens a regrade request on a graded submission.
+	RequestRegrade(context.Context, *RequestRegradeRequest) (*RequestRegradeResponse, error)
+	// Accepts or rejects an open regrade request; accepting replaces the score of the grade.
+	ResolveRegrade(context.Context, *ResolveRegradeRequest) (*ResolveRegradeResponse, error)
+	// Returns the open regrade requests of a course, oldest first.
+	ListOpenRegrades(context.Context, *ListOpenRegradesRequest) (*ListOpenRegradesResponse, error)
+	// Completes the next step of a homework's workflow for a student.
+	// Steps must be completed in order; anything else is rejected.
+	AdvanceWorkflow(context.Context, *AdvanceWorkflowRequest) (*AdvanceWorkflowResponse, error)
+	// Returns the workflow progress of one student, or of every student who started it.
+	GetWorkflowProgress(context.Context, *GetWorkflowProgressRequest) (*GetWorkflowProgressResponse, error)
 	mustEmbedUnimplementedHomeworkServiceServer()
 }
 
@@ -118,6 +421,9 @@ type UnimplementedHomeworkServiceServer struct{}
 func (UnimplementedHomeworkServiceServer) GetHomework(context.Context, *GetHomeworkRequest) (*GetHomeworkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHomework not implemented")
 }
+func (UnimplementedHomeworkServiceServer) ListHomeworks(context.Context, *ListHomeworksRequest) (*ListHomeworksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHomeworks not implemented")
+}
 func (UnimplementedHomeworkServiceServer) CreateHomework(context.Context, *CreateHomeworkRequest) (*CreateHomeworkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateHomework not implemented")
 }
@@ -127,6 +433,60 @@ func (UnimplementedHomeworkServiceServer) UpdateHomework(context.Context, *Updat
 func (UnimplementedHomeworkServiceServer) DeleteHomework(context.Context, *DeleteHomeworkRequest) (*DeleteHomeworkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteHomework not implemented")
 }
+func (UnimplementedHomeworkServiceServer) SubmitHomework(context.Context, *SubmitHomeworkRequest) (*SubmitHomeworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitHomework not implemented")
+}
+func (UnimplementedHomeworkServiceServer) GetSubmissions(context.Context, *GetSubmissionsRequest) (*GetSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissions not implemented")
+}
+func (UnimplementedHomeworkServiceServer) GetStudentSubmissions(context.Context, *GetStudentSubmissionsRequest) (*GetStudentSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentSubmissions not implemented")
+}
+func (UnimplementedHomeworkServiceServer) ListSubmissionVersions(context.Context, *ListSubmissionVersionsRequest) (*ListSubmissionVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubmissionVersions not implemented")
+}
+func (UnimplementedHomeworkServiceServer) SelectFinalSubmission(context.Context, *SelectFinalSubmissionRequest) (*SelectFinalSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectFinalSubmission not implemented")
+}
+func (UnimplementedHomeworkServiceServer) UploadFile(grpc.ClientStreamingServer[UploadFileRequest, UploadFileResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+}
+func (UnimplementedHomeworkServiceServer) DownloadFile(*DownloadFileRequest, grpc.ServerStreamingServer[DownloadFileResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
+}
+func (UnimplementedHomeworkServiceServer) GrantExtension(context.Context, *GrantExtensionRequest) (*GrantExtensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantExtension not implemented")
+}
+func (UnimplementedHomeworkServiceServer) RevokeExtension(context.Context, *RevokeExtensionRequest) (*RevokeExtensionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeExtension not implemented")
+}
+func (UnimplementedHomeworkServiceServer) ListExtensions(context.Context, *ListExtensionsRequest) (*ListExtensionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExtensions not implemented")
+}
+func (UnimplementedHomeworkServiceServer) SetGrade(context.Context, *SetGradeRequest) (*SetGradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGrade not implemented")
+}
+func (UnimplementedHomeworkServiceServer) GetGrades(context.Context, *GetGradesRequest) (*GetGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGrades not implemented")
+}
+func (UnimplementedHomeworkServiceServer) GetStudentGrades(context.Context, *GetStudentGradesRequest) (*GetStudentGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentGrades not implemented")
+}
+func (UnimplementedHomeworkServiceServer) RequestRegrade(context.Context, *RequestRegradeRequest) (*RequestRegradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRegrade not implemented")
+}
+func (UnimplementedHomeworkServiceServer) ResolveRegrade(context.Context, *ResolveRegradeRequest) (*ResolveRegradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveRegrade not implemented")
+}
+func (UnimplementedHomeworkServiceServer) ListOpenRegrades(context.Context, *ListOpenRegradesRequest) (*ListOpenRegradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOpenRegrades not implemented")
+}
+func (UnimplementedHomeworkServiceServer) AdvanceWorkflow(context.Context, *AdvanceWorkflowRequest) (*AdvanceWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdvanceWorkflow not implemented")
+}
+func (UnimplementedHomeworkServiceServer) GetWorkflowProgress(context.Context, *GetWorkflowProgressRequest) (*GetWorkflowProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowProgress not implemented")
+}
 func (UnimplementedHomeworkServiceServer) mustEmbedUnimplementedHomeworkServiceServer() {}
 func (UnimplementedHomeworkServiceServer) testEmbeddedByValue()                         {}
 
@@ -166,6 +526,24 @@ func _HomeworkService_GetHomework_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HomeworkService_ListHomeworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHomeworksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).ListHomeworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_ListHomeworks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).ListHomeworks(ctx, req.(*ListHomeworksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HomeworkService_CreateHomework_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateHomeworkRequest)
 	if err := dec(in); err != nil {
@@ -220,6 +598,312 @@ func _HomeworkService_DeleteHomework_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HomeworkService_SubmitHomework_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitHomeworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).SubmitHomework(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_SubmitHomework_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).SubmitHomework(ctx, req.(*SubmitHomeworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_GetSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).GetSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_GetSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).GetSubmissions(ctx, req.(*GetSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_GetStudentSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).GetStudentSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_GetStudentSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).GetStudentSubmissions(ctx, req.(*GetStudentSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_ListSubmissionVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubmissionVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).ListSubmissionVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_ListSubmissionVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).ListSubmissionVersions(ctx, req.(*ListSubmissionVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_SelectFinalSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectFinalSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).SelectFinalSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_SelectFinalSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).SelectFinalSubmission(ctx, req.(*SelectFinalSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_UploadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(HomeworkServiceServer).UploadFile(&grpc.GenericServerStream[UploadFileRequest, UploadFileResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HomeworkService_UploadFileServer = grpc.ClientStreamingServer[UploadFileRequest, UploadFileResponse]
+
+func _HomeworkService_DownloadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HomeworkServiceServer).DownloadFile(m, &grpc.GenericServerStream[DownloadFileRequest, DownloadFileResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type HomeworkService_DownloadFileServer = grpc.ServerStreamingServer[DownloadFileResponse]
+
+func _HomeworkService_GrantExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).GrantExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_GrantExtension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).GrantExtension(ctx, req.(*GrantExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_RevokeExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).RevokeExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_RevokeExtension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).RevokeExtension(ctx, req.(*RevokeExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_ListExtensions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExtensionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).ListExtensions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_ListExtensions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).ListExtensions(ctx, req.(*ListExtensionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_SetGrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).SetGrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_SetGrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).SetGrade(ctx, req.(*SetGradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_GetGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).GetGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_GetGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).GetGrades(ctx, req.(*GetGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_GetStudentGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).GetStudentGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_GetStudentGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).GetStudentGrades(ctx, req.(*GetStudentGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_RequestRegrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestRegradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).RequestRegrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_RequestRegrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).RequestRegrade(ctx, req.(*RequestRegradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_ResolveRegrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveRegradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).ResolveRegrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_ResolveRegrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).ResolveRegrade(ctx, req.(*ResolveRegradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_ListOpenRegrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOpenRegradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).ListOpenRegrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_ListOpenRegrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).ListOpenRegrades(ctx, req.(*ListOpenRegradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_AdvanceWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdvanceWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).AdvanceWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_AdvanceWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).AdvanceWorkflow(ctx, req.(*AdvanceWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HomeworkService_GetWorkflowProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HomeworkServiceServer).GetWorkflowProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HomeworkService_GetWorkflowProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HomeworkServiceServer).GetWorkflowProgress(ctx, req.(*GetWorkflowProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HomeworkService_ServiceDesc is the grpc.ServiceDesc for HomeworkService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -231,6 +915,10 @@ var HomeworkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHomework",
 			Handler:    _HomeworkService_GetHomework_Handler,
 		},
+		{
+			MethodName: "ListHomeworks",
+			Handler:    _HomeworkService_ListHomeworks_Handler,
+		},
 		{
 			MethodName: "CreateHomework",
 			Handler:    _HomeworkService_CreateHomework_Handler,
@@ -243,7 +931,82 @@ var HomeworkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteHomework",
 			Handler:    _HomeworkService_DeleteHomework_Handler,
 		},
+		{
+			MethodName: "SubmitHomework",
+			Handler:    _HomeworkService_SubmitHomework_Handler,
+		},
+		{
+			MethodName: "GetSubmissions",
+			Handler:    _HomeworkService_GetSubmissions_Handler,
+		},
+		{
+			MethodName: "GetStudentSubmissions",
+			Handler:    _HomeworkService_GetStudentSubmissions_Handler,
+		},
+		{
+			MethodName: "ListSubmissionVersions",
+			Handler:    _HomeworkService_ListSubmissionVersions_Handler,
+		},
+		{
+			MethodName: "SelectFinalSubmission",
+			Handler:    _HomeworkService_SelectFinalSubmission_Handler,
+		},
+		{
+			MethodName: "GrantExtension",
+			Handler:    _HomeworkService_GrantExtension_Handler,
+		},
+		{
+			MethodName: "RevokeExtension",
+			Handler:    _HomeworkService_RevokeExtension_Handler,
+		},
+		{
+			MethodName: "ListExtensions",
+			Handler:    _HomeworkService_ListExtensions_Handler,
+		},
+		{
+			MethodName: "SetGrade",
+			Handler:    _HomeworkService_SetGrade_Handler,
+		},
+		{
+			MethodName: "GetGrades",
+			Handler:    _HomeworkService_GetGrades_Handler,
+		},
+		{
+			MethodName: "GetStudentGrades",
+			Handler:    _HomeworkService_GetStudentGrades_Handler,
+		},
+		{
+			MethodName: "RequestRegrade",
+			Handler:    _HomeworkService_RequestRegrade_Handler,
+		},
+		{
+			MethodName: "ResolveRegrade",
+			Handler:    _HomeworkService_ResolveRegrade_Handler,
+		},
+		{
+			MethodName: "ListOpenRegrades",
+			Handler:    _HomeworkService_ListOpenRegrades_Handler,
+		},
+		{
+			MethodName: "AdvanceWorkflow",
+			Handler:    _HomeworkService_AdvanceWorkflow_Handler,
+		},
+		{
+			MethodName: "GetWorkflowProgress",
+			Handler:    _HomeworkService_GetWorkflowProgress_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadFile",
+			Handler:       _HomeworkService_UploadFile_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadFile",
+			Handler:       _HomeworkService_DownloadFile_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "homework-microservice.proto",
 }
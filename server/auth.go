@@ -0,0 +1,355 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	ms "github.com/TekClinic/MicroService-Lib"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// roleAdmin grants every permission in every course.
+	roleAdmin = "admin"
+	// roleStaff and roleStudent are granted per course, as "<role>:<courseId>".
+	roleStaff   = "staff"
+	roleStudent = "student"
+	// separates a course role from the course ID.
+	courseRoleSeparator = ":"
+	// default token claim identifying the caller; overridden by AUTH_SUBJECT_CLAIM.
+	defaultSubjectClaim = "sub"
+	// metadata key and scheme carrying the caller's token.
+	authorizationHeader = "authorization"
+	bearerScheme        = "bearer"
+)
+
+// callerKey is the context key of the request's authState.
+type callerKey struct{}
+
+// authState holds the authenticated caller of a request. On streams from clients that still
+// send the token in the first message, it is only filled once that message is received.
+type authState struct {
+	caller *principal
+}
+
+// principal is the authenticated caller of an RPC.
+type principal struct {
+	// id identifies the caller; for students it is their student ID.
+	id     string
+	claims ms.Claims
+}
+
+// isAdmin reports whether the caller is an administrator.
+func (p *principal) isAdmin() bool {
+	return p.claims.HasRole(roleAdmin)
+}
+
+// hasCourseRole reports whether the caller holds the role in the course. Admins hold every role.
+func (p *principal) hasCourseRole(courseID, role string) bool {
+	return p.isAdmin() || p.claims.HasRole(role+courseRoleSeparator+courseID)
+}
+
+// isStaff reports whether the caller is staff of the course.
+func (p *principal) isStaff(courseID string) bool {
+	return p.hasCourseRole(courseID, roleStaff)
+}
+
+// isMember reports whether the caller is staff or a student of the course.
+func (p *principal) isMember(courseID string) bool {
+	return p.isStaff(courseID) || p.hasCourseRole(courseID, roleStudent)
+}
+
+// actsFor reports whether the caller may act as the given student of the course.
+func (p *principal) actsFor(courseID, studentID string) bool {
+	return p.isAdmin() || (p.id == studentID && p.hasCourseRole(courseID, roleStudent))
+}
+
+// canView reports whether the caller may see the given student's records in the course.
+func (p *principal) canView(courseID, studentID string) bool {
+	return p.isStaff(courseID) || p.actsFor(courseID, studentID)
+}
+
+// ownsSubmission reports whether the caller made the submission or belongs to its group.
+func (p *principal) ownsSubmission(courseID string, submission *hpb.Submission) bool {
+	return p.actsFor(courseID, submission.GetStudentId()) ||
+		(slices.Contains(submission.GetPartnersId(), p.id) && p.hasCourseRole(courseID, roleStudent))
+}
+
+// staffCourses returns the courses the caller is staff of. Admins, who are staff of
+// every course, get nil.
+func (p *principal) staffCourses() []string {
+	if p.isAdmin() {
+		return nil
+	}
+
+	courses := []string{}
+
+	for role := range p.claims.GetRoles() {
+		if course, ok := strings.CutPrefix(role, roleStaff+courseRoleSeparator); ok {
+			courses = append(courses, course)
+		}
+	}
+
+	return courses
+}
+
+// authenticate verifies the token and identifies the caller by the configured subject claim.
+func (s *HomeworkServer) authenticate(ctx context.Context, token string) (*principal, error) {
+	claims, err := s.VerifyToken(ctx, token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+
+	id, err := tokenSubject(token, s.subjectClaim)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "authentication failed: %v", err)
+	}
+
+	return &principal{id: id, claims: claims}, nil
+}
+
+// callerFrom returns the caller authenticated by the interceptors for the request's context.
+func callerFrom(ctx context.Context) (*principal, error) {
+	state, ok := ctx.Value(callerKey{}).(*authState)
+	if !ok || state.caller == nil {
+		return nil, status.Error(codes.Unauthenticated, "request is not authenticated")
+	}
+
+	return state.caller, nil
+}
+
+// metadataToken returns the bearer token sent in the request metadata, if any.
+func metadataToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return "", nil
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, bearerScheme) || token == "" {
+		return "", status.Errorf(codes.Unauthenticated, "%s metadata must be a bearer token", authorizationHeader)
+	}
+
+	return token, nil
+}
+
+// messageToken returns the deprecated token carried by a request message, if any.
+func messageToken(msg any) string {
+	if upload, ok := msg.(*hpb.UploadFileRequest); ok {
+		return upload.GetHeader().GetToken() //nolint:staticcheck // accepted from older clients.
+	}
+
+	if m, ok := msg.(interface{ GetToken() string }); ok {
+		return m.GetToken()
+	}
+
+	return ""
+}
+
+// unaryAuthInterceptor authenticates the caller by the metadata token, falling back to the
+// token of the request message, and stores the caller in the handler's context.
+func (s *HomeworkServer) unaryAuthInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	token, err := metadataToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if token == "" {
+		token = messageToken(req)
+	}
+
+	caller, err := s.authenticate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(context.WithValue(ctx, callerKey{}, &authState{caller: caller}), req)
+}
+
+// streamAuthInterceptor authenticates the caller of a stream by the metadata token. Without
+// one, the caller is authenticated by the token of the first message the handler receives.
+func (s *HomeworkServer) streamAuthInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx := stream.Context()
+
+	token, err := metadataToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	state := &authState{}
+
+	if token != "" {
+		if state.caller, err = s.authenticate(ctx, token); err != nil {
+			return err
+		}
+	}
+
+	return handler(srv, &authStream{
+		ServerStream: stream,
+		ctx:          context.WithValue(ctx, callerKey{}, state),
+		server:       s,
+		state:        state,
+	})
+}
+
+// authStream is a server stream whose context carries the caller, authenticating it by
+// the first received message when the metadata had no token.
+type authStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	server *HomeworkServer
+	state  *authState
+}
+
+// Context returns the stream's context, carrying its authState.
+func (a *authStream) Context() context.Context {
+	return a.ctx
+}
+
+// RecvMsg receives a message, authenticating the caller by it if not yet authenticated.
+func (a *authStream) RecvMsg(m any) error {
+	if err := a.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if a.state.caller != nil {
+		return nil
+	}
+
+	caller, err := a.server.authenticate(a.ctx, messageToken(m))
+	if err != nil {
+		return err
+	}
+
+	a.state.caller = caller
+
+	return nil
+}
+
+// tokenSubject reads the claim identifying the caller from the payload of a JWT.
+// The token's signature must already have been verified.
+func tokenSubject(token, claim string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 { //nolint:mnd // header, payload and signature.
+		return "", errors.New("malformed token")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("malformed token payload: %w", err)
+	}
+
+	var claims map[string]any
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("malformed token payload: %w", err)
+	}
+
+	subject, ok := claims[claim].(string)
+	if !ok || subject == "" {
+		return "", fmt.Errorf("token has no %q claim", claim)
+	}
+
+	return subject, nil
+}
+
+// authorizeHomework retrieves a homework and checks that the caller may act on it.
+// It returns NotFound for missing homeworks and PermissionDenied when allowed rejects the caller.
+func (s *HomeworkServer) authorizeHomework(ctx context.Context, id string,
+	allowed func(courseID string) bool,
+) (*hpb.Homework, error) {
+	homework, err := s.db.GetHomework(ctx, id)
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "failed to get homework", "id", id)
+
+		return nil, statusError(err, "failed to get homework %s", id)
+	}
+
+	if !allowed(homework.GetCourseId()) {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to access homework %s", id)
+	}
+
+	return homework, nil
+}
+
+// authorizeSubmission retrieves a submission and its homework and checks that the caller may act on them.
+func (s *HomeworkServer) authorizeSubmission(ctx context.Context, id string,
+	allowed func(courseID string, submission *hpb.Submission) bool,
+) (*hpb.Submission, *hpb.Homework, error) {
+	submission, err := s.db.GetSubmission(ctx, id)
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "failed to get submission", "submissionId", id)
+
+		return nil, nil, statusError(err, "failed to get submission %s", id)
+	}
+
+	homework, err := s.authorizeHomework(ctx, submission.GetHomeworkId(), func(courseID string) bool {
+		return allowed(courseID, submission)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return submission, homework, nil
+}
+
+// studentRecordCourses returns the courses in which the caller may see the given student's
+// records across homeworks: nil for all of them when the caller is the student or an admin,
+// otherwise the courses the caller is staff of.
+func studentRecordCourses(caller *principal, studentID string) ([]string, error) {
+	if caller.id == studentID || caller.isAdmin() {
+		return nil, nil
+	}
+
+	courses := caller.staffCourses()
+	if len(courses) == 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to access the records of student %s",
+			studentID)
+	}
+
+	return courses, nil
+}
+
+// submissionFilter returns the student whose submissions the caller may see in the course,
+// or an empty string when the caller is staff and may see every submission.
+func submissionFilter(caller *principal, courseID string) string {
+	if caller.isStaff(courseID) {
+		return ""
+	}
+
+	return caller.id
+}
+
+// canDownload reports whether the caller may download the stored file of the homework:
+// staff may download any file, students the homework's own files, those of the submissions
+// they can see and their own uploads, even before they are attached.
+func (s *HomeworkServer) canDownload(ctx context.Context, caller *principal, homework *hpb.Homework,
+	stored *StoredFile,
+) (bool, error) {
+	if caller.isStaff(homework.GetCourseId()) || stored.UploadedBy == caller.id {
+		return true, nil
+	}
+
+	for _, file := range homework.GetFiles() {
+		if file.GetContentRef() == stored.ContentRef {
+			return true, nil
+		}
+	}
+
+	return s.db.HasSubmissionFile(ctx, homework.GetId(), caller.id, stored.ContentRef)
+}
@@ -0,0 +1,413 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// testPrincipal is a caller with the given ID and roles.
+func testPrincipal(id string, roles ...string) *principal {
+	return &principal{id: id, claims: testClaims{roles: sets.New(roles...)}}
+}
+
+func TestPrincipalRoles(t *testing.T) {
+	admin := testPrincipal("admin-1", roleAdmin)
+	staff := testPrincipal("staff-1", roleStaff+courseRoleSeparator+testCourse)
+	student := testPrincipal("student-1", roleStudent+courseRoleSeparator+testCourse)
+	outsider := testPrincipal("student-3", roleStudent+courseRoleSeparator+testOtherCourse)
+
+	tests := []struct {
+		name   string
+		check  func(p *principal) bool
+		admin  bool
+		staff  bool
+		member bool
+		other  bool
+	}{
+		{"is staff", func(p *principal) bool { return p.isStaff(testCourse) }, true, true, false, false},
+		{"is member", func(p *principal) bool { return p.isMember(testCourse) }, true, true, true, false},
+		{"acts for student-1", func(p *principal) bool { return p.actsFor(testCourse, "student-1") },
+			true, false, true, false},
+		{"views student-1", func(p *principal) bool { return p.canView(testCourse, "student-1") },
+			true, true, true, false},
+		{"views student-2", func(p *principal) bool { return p.canView(testCourse, "student-2") },
+			true, true, false, false},
+		{"owns a group submission", func(p *principal) bool {
+			return p.ownsSubmission(testCourse, &hpb.Submission{StudentId: "student-2", PartnersId: []string{"student-1"}})
+		}, true, false, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for p, want := range map[*principal]bool{admin: tt.admin, staff: tt.staff, student: tt.member, outsider: tt.other} {
+				if got := tt.check(p); got != want {
+					t.Errorf("%s: got %v, want %v", p.id, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestStaffCourses(t *testing.T) {
+	if courses := testPrincipal("admin-1", roleAdmin).staffCourses(); courses != nil {
+		t.Fatalf("admin staff courses = %v, want nil", courses)
+	}
+
+	caller := testPrincipal("staff-1", roleStaff+courseRoleSeparator+testCourse,
+		roleStaff+courseRoleSeparator+testOtherCourse, roleStudent+courseRoleSeparator+"course-3")
+
+	courses := caller.staffCourses()
+	slices.Sort(courses)
+
+	if !slices.Equal(courses, []string{testCourse, testOtherCourse}) {
+		t.Fatalf("staff courses = %v", courses)
+	}
+}
+
+func TestTokenSubject(t *testing.T) {
+	if subject, err := tokenSubject(testToken(t, "student-1"), defaultSubjectClaim); err != nil || subject != "student-1" {
+		t.Fatalf("tokenSubject = %q (%v), want student-1", subject, err)
+	}
+
+	for _, token := range []string{"not-a-jwt", "a.%%%.c", "a.bm90IGpzb24.c"} {
+		if _, err := tokenSubject(token, defaultSubjectClaim); err == nil {
+			t.Errorf("tokenSubject accepted %q", token)
+		}
+	}
+
+	if _, err := tokenSubject(testToken(t, "student-1"), "email"); err == nil {
+		t.Error("tokenSubject accepted a token without the claim")
+	}
+}
+
+func TestStudentRecordCourses(t *testing.T) {
+	staff := testPrincipal("staff-1", roleStaff+courseRoleSeparator+testCourse)
+
+	if courses, err := studentRecordCourses(testPrincipal("student-1"), "student-1"); err != nil || courses != nil {
+		t.Fatalf("own records = %v (%v), want every course", courses, err)
+	}
+
+	courses, err := studentRecordCourses(staff, "student-1")
+	if err != nil || !slices.Equal(courses, []string{testCourse}) {
+		t.Fatalf("staff records = %v (%v), want %s", courses, err, testCourse)
+	}
+
+	_, err = studentRecordCourses(testPrincipal("student-2", roleStudent+courseRoleSeparator+testCourse), "student-1")
+	wantCode(t, err, codes.PermissionDenied)
+}
+
+func TestSubmissionFilter(t *testing.T) {
+	staff := testPrincipal("staff-1", roleStaff+courseRoleSeparator+testCourse)
+	student := testPrincipal("student-1", roleStudent+courseRoleSeparator+testCourse)
+
+	if filter := submissionFilter(staff, testCourse); filter != "" {
+		t.Fatalf("staff filter = %q, want none", filter)
+	}
+
+	if filter := submissionFilter(staff, testOtherCourse); filter != "staff-1" {
+		t.Fatalf("staff filter in another course = %q, want their own", filter)
+	}
+
+	if filter := submissionFilter(student, testCourse); filter != "student-1" {
+		t.Fatalf("student filter = %q, want their own", filter)
+	}
+}
+
+func TestCanDownloadHomeworkFiles(t *testing.T) {
+	// homework files and own uploads are checked without reaching the database.
+	server, _ := newValidationServer(t)
+	homework := &hpb.Homework{Id: "hw-1", CourseId: testCourse, Files: []*hpb.File{{ContentRef: "spec"}}}
+
+	tests := []struct {
+		name   string
+		caller *principal
+		stored *StoredFile
+	}{
+		{"staff", testPrincipal("staff-1", roleStaff+courseRoleSeparator+testCourse),
+			&StoredFile{ContentRef: "submission", UploadedBy: "student-1"}},
+		{"student", testPrincipal("student-1", roleStudent+courseRoleSeparator+testCourse),
+			&StoredFile{ContentRef: "spec", UploadedBy: "staff-1"}},
+		{"uploader", testPrincipal("student-1", roleStudent+courseRoleSeparator+testCourse),
+			&StoredFile{ContentRef: "upload", UploadedBy: "student-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, err := server.canDownload(context.Background(), tt.caller, homework, tt.stored)
+			if err != nil || !allowed {
+				t.Fatalf("canDownload = %v (%v), want allowed", allowed, err)
+			}
+		})
+	}
+}
+
+// callerID is a handler returning the ID of the caller the interceptors authenticated.
+func callerID(ctx context.Context, _ any) (any, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return caller.id, nil
+}
+
+//nolint:staticcheck // the deprecated message token is still accepted.
+func TestUnaryAuthInterceptor(t *testing.T) {
+	server, _ := newValidationServer(t)
+	token := testToken(t, "student-1")
+
+	tests := []struct {
+		name          string
+		authorization string
+		req           any
+		want          codes.Code
+	}{
+		{"bearer metadata", "Bearer " + token, &hpb.GetHomeworkRequest{}, codes.OK},
+		{"lowercase scheme", "bearer " + token, &hpb.GetHomeworkRequest{}, codes.OK},
+		{"message token", "", &hpb.GetHomeworkRequest{Token: token}, codes.OK},
+		{"metadata wins over the message", "Bearer " + token, &hpb.GetHomeworkRequest{Token: "forged"}, codes.OK},
+		{"other scheme", "Basic " + token, &hpb.GetHomeworkRequest{}, codes.Unauthenticated},
+		{"forged token", "Bearer forged", &hpb.GetHomeworkRequest{}, codes.Unauthenticated},
+		{"no token", "", &hpb.GetHomeworkRequest{}, codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationHeader, tt.authorization))
+			}
+
+			id, err := server.unaryAuthInterceptor(ctx, tt.req, nil, callerID)
+			wantCode(t, err, tt.want)
+
+			if err == nil && id != "student-1" {
+				t.Fatalf("caller = %v, want student-1", id)
+			}
+		})
+	}
+}
+
+// fakeServerStream replays a fixed list of messages to RecvMsg.
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	messages []proto.Message
+}
+
+func (f *fakeServerStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeServerStream) RecvMsg(m any) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+
+	proto.Merge(msg, f.messages[0])
+	f.messages = f.messages[1:]
+
+	return nil
+}
+
+//nolint:staticcheck // the deprecated message token is still accepted.
+func TestStreamAuthInterceptor(t *testing.T) {
+	server, _ := newValidationServer(t)
+	token := testToken(t, "student-1")
+	header := func(token string) proto.Message {
+		return &hpb.UploadFileRequest{Data: &hpb.UploadFileRequest_Header{Header: &hpb.UploadFileHeader{Token: token}}}
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		first         proto.Message
+		want          codes.Code
+	}{
+		{"bearer metadata", "Bearer " + token, header(""), codes.OK},
+		{"token in the first message", "", header(token), codes.OK},
+		{"forged first message", "", header("forged"), codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationHeader, tt.authorization))
+			}
+
+			stream := &fakeServerStream{ctx: ctx, messages: []proto.Message{tt.first}}
+
+			err := server.streamAuthInterceptor(nil, stream, nil, func(_ any, stream grpc.ServerStream) error {
+				if err := stream.RecvMsg(&hpb.UploadFileRequest{}); err != nil {
+					return err
+				}
+
+				id, err := callerID(stream.Context(), nil)
+				if err == nil && id != "student-1" {
+					t.Errorf("caller = %v, want student-1", id)
+				}
+
+				return err
+			})
+			wantCode(t, err, tt.want)
+		})
+	}
+}
+
+func TestStudentIsolation(t *testing.T) {
+	course := newCourseTest(t, newTestHomework("hw-1"))
+	client, staff, student := course.client, course.staff, course.student
+
+	submit := func(studentID string) *hpb.Submission {
+		t.Helper()
+
+		resp, err := client.SubmitHomework(course.asStudent(t, studentID), &hpb.SubmitHomeworkRequest{
+			Id: "hw-1",
+			Submission: &hpb.Submission{
+				StudentId:      studentID,
+				SubmissionFile: &hpb.File{Filename: "solution.txt", Content: []byte("by " + studentID)},
+			},
+		})
+		if err != nil {
+			t.Fatalf("SubmitHomework: %v", err)
+		}
+
+		return resp.GetSubmission()
+	}
+
+	submit("student-1")
+	other := submit("student-2")
+
+	got, err := client.GetSubmissions(student, &hpb.GetSubmissionsRequest{HomeworkId: "hw-1"})
+	if err != nil {
+		t.Fatalf("GetSubmissions: %v", err)
+	}
+
+	if len(got.GetSubmissions()) != 1 || got.GetSubmissions()[0].GetStudentId() != "student-1" {
+		t.Fatalf("GetSubmissions returned %v to student-1, want only their own submission", got.GetSubmissions())
+	}
+
+	all, err := client.GetSubmissions(staff, &hpb.GetSubmissionsRequest{HomeworkId: "hw-1"})
+	if err != nil {
+		t.Fatalf("GetSubmissions: %v", err)
+	}
+
+	if len(all.GetSubmissions()) != 2 {
+		t.Fatalf("GetSubmissions returned %d submissions to staff, want 2", len(all.GetSubmissions()))
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"download another student's file", func() error {
+			_, _, err := download(student, client, &hpb.DownloadFileRequest{
+				HomeworkId: "hw-1",
+				ContentRef: other.GetSubmissionFile().GetContentRef(),
+			})
+			return err
+		}},
+		{"another student's submissions", func() error {
+			_, err := client.GetStudentSubmissions(student, &hpb.GetStudentSubmissionsRequest{StudentId: "student-2"})
+			return err
+		}},
+		{"another student's versions", func() error {
+			_, err := client.ListSubmissionVersions(student, &hpb.ListSubmissionVersionsRequest{
+				HomeworkId: "hw-1", StudentId: "student-2",
+			})
+			return err
+		}},
+		{"select another student's submission", func() error {
+			_, err := client.SelectFinalSubmission(student, &hpb.SelectFinalSubmissionRequest{
+				SubmissionId: other.GetId(),
+			})
+			return err
+		}},
+		{"another student's grades", func() error {
+			_, err := client.GetStudentGrades(student, &hpb.GetStudentGradesRequest{StudentId: "student-2"})
+			return err
+		}},
+		{"grades of the homework", func() error {
+			_, err := client.GetGrades(student, &hpb.GetGradesRequest{HomeworkId: "hw-1"})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantCode(t, tt.call(), codes.PermissionDenied)
+		})
+	}
+
+	// staff may download every submission of their course.
+	if _, content, err := download(staff, client, &hpb.DownloadFileRequest{
+		HomeworkId: "hw-1",
+		ContentRef: other.GetSubmissionFile().GetContentRef(),
+	}); err != nil || string(content) != "by student-2" {
+		t.Fatalf("DownloadFile by staff = %q, %v", content, err)
+	}
+}
+
+//nolint:staticcheck // the deprecated message token is still accepted.
+func TestLegacyMessageToken(t *testing.T) {
+	course := newCourseTest(t)
+	client := course.client
+	token := testToken(t, "teacher", roleStaff+courseRoleSeparator+testCourse)
+
+	// older clients send the token in the request message instead of the metadata.
+	created, err := client.CreateHomework(context.Background(), &hpb.CreateHomeworkRequest{
+		Token:    token,
+		Homework: newTestHomework("hw-1"),
+	})
+	if err != nil {
+		t.Fatalf("CreateHomework with a message token: %v", err)
+	}
+
+	content := []byte("solution")
+
+	file, err := upload(context.Background(), client, &hpb.UploadFileHeader{
+		Token:      token,
+		HomeworkId: "hw-1",
+		Filename:   "solution.txt",
+	}, content, 3, checksumOf(content))
+	if err != nil {
+		t.Fatalf("UploadFile with a header token: %v", err)
+	}
+
+	_, got, err := download(context.Background(), client, &hpb.DownloadFileRequest{
+		Token:      token,
+		HomeworkId: "hw-1",
+		ContentRef: file.GetContentRef(),
+	})
+	if err != nil || string(got) != string(content) {
+		t.Fatalf("DownloadFile with a message token = %q, %v", got, err)
+	}
+
+	// the metadata token takes precedence over the message token.
+	_, err = client.DeleteHomework(course.student, &hpb.DeleteHomeworkRequest{Token: token, Id: "hw-1"})
+	wantCode(t, err, codes.PermissionDenied)
+
+	_, err = client.GetHomework(context.Background(), &hpb.GetHomeworkRequest{Token: "forged", Id: "hw-1"})
+	wantCode(t, err, codes.Unauthenticated)
+
+	_, err = upload(context.Background(), client, &hpb.UploadFileHeader{HomeworkId: "hw-1"}, content, 3,
+		checksumOf(content))
+	wantCode(t, err, codes.Unauthenticated)
+
+	_, _, err = download(context.Background(), client, &hpb.DownloadFileRequest{
+		HomeworkId: "hw-1",
+		ContentRef: created.GetHw().GetFiles()[0].GetContentRef(),
+	})
+	wantCode(t, err, codes.Unauthenticated)
+}
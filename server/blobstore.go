@@ -0,0 +1,267 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	ms "github.com/TekClinic/MicroService-Lib"
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"k8s.io/klog/v2"
+)
+
+const (
+	blobStoreLocal = "local"
+	blobStoreS3    = "s3"
+	// default directory of the local blob store.
+	defaultBlobDir = "blobs"
+	// permissions of directories created by the local blob store.
+	blobDirPerm = 0o750
+	// size of the parts S3 uploads of unknown length are sent in; each part is buffered in memory.
+	s3PartSize = 16 << 20
+)
+
+// errBlobNotFound is returned when no content is stored under the requested key.
+var errBlobNotFound = errors.New("blob not found")
+
+// BlobStore stores file contents outside of the database, addressed by key.
+type BlobStore interface {
+	// Put stores the content read from r under key.
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	// Get opens the content stored under key, starting at the given byte offset.
+	// The caller must close the reader.
+	Get(ctx context.Context, key string, offset int64) (io.ReadCloser, error)
+	// Delete removes the content stored under key.
+	Delete(ctx context.Context, key string) error
+}
+
+// InitializeBlobStore creates the blob store selected by the BLOB_STORE environment variable.
+func InitializeBlobStore(ctx context.Context) (BlobStore, error) {
+	switch kind := ms.GetOptionalEnv("BLOB_STORE", blobStoreLocal); kind {
+	case blobStoreLocal:
+		return NewLocalBlobStore(ms.GetOptionalEnv("BLOB_DIR", defaultBlobDir))
+	case blobStoreS3:
+		return newS3BlobStoreFromEnv(ctx)
+	default:
+		return nil, fmt.Errorf("unknown blob store %q", kind)
+	}
+}
+
+// LocalBlobStore keeps blobs as files under a root directory.
+type LocalBlobStore struct {
+	root string
+}
+
+// NewLocalBlobStore creates a blob store rooted at dir, creating the directory if needed.
+func NewLocalBlobStore(dir string) (*LocalBlobStore, error) {
+	if err := os.MkdirAll(dir, blobDirPerm); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	klog.Infof("Using local blob store at %s.", dir)
+
+	return &LocalBlobStore{root: dir}, nil
+}
+
+// path maps a key to a file path, rejecting keys that escape the root directory.
+func (l *LocalBlobStore) path(key string) (string, error) {
+	if !filepath.IsLocal(key) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return filepath.Join(l.root, filepath.FromSlash(key)), nil
+}
+
+// Put implements BlobStore.Put by writing to a temporary file and renaming it into place.
+func (l *LocalBlobStore) Put(_ context.Context, key string, r io.Reader, _ string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), blobDirPerm); err != nil {
+		return fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create blob: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store blob: %w", err)
+	}
+
+	return nil
+}
+
+// Get implements BlobStore.Get.
+func (l *LocalBlobStore) Get(_ context.Context, key string, offset int64) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", errBlobNotFound, key)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blob: %w", err)
+	}
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to seek blob: %w", err)
+	}
+
+	return file, nil
+}
+
+// Delete implements BlobStore.Delete. Deleting a missing blob is not an error.
+func (l *LocalBlobStore) Delete(_ context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+
+	return nil
+}
+
+// S3BlobStore keeps blobs as objects in a bucket of any S3-compatible service,
+// such as AWS S3 or a local MinIO instance.
+type S3BlobStore struct {
+	client *minio.Client
+	bucket string
+}
+
+// newS3BlobStoreFromEnv creates an S3BlobStore configured by the S3_* environment variables.
+func newS3BlobStoreFromEnv(ctx context.Context) (*S3BlobStore, error) {
+	endpoint, err := ms.GetRequiredEnv("S3_ENDPOINT")
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, err := ms.GetRequiredEnv("S3_BUCKET")
+	if err != nil {
+		return nil, err
+	}
+
+	secure, err := strconv.ParseBool(ms.GetOptionalEnv("S3_USE_SSL", "true"))
+	if err != nil {
+		return nil, fmt.Errorf("S3_USE_SSL is not a boolean: %w", err)
+	}
+
+	return NewS3BlobStore(ctx, endpoint, bucket, &minio.Options{
+		Creds: credentials.NewStaticV4(os.Getenv("S3_ACCESS_KEY_ID"),
+			os.Getenv("S3_SECRET_ACCESS_KEY"), ""),
+		Secure: secure,
+		Region: os.Getenv("S3_REGION"),
+	})
+}
+
+// NewS3BlobStore connects to the S3-compatible endpoint and creates the bucket if it doesn't exist.
+func NewS3BlobStore(ctx context.Context, endpoint, bucket string, opts *minio.Options) (*S3BlobStore, error) {
+	client, err := minio.New(endpoint, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	exists, err := client.BucketExists(ctx, bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check bucket existence: %w", err)
+	}
+
+	if !exists {
+		if err := client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{Region: opts.Region}); err != nil {
+			return nil, fmt.Errorf("failed to create bucket: %w", err)
+		}
+
+		klog.Infof("Bucket %s created successfully.", bucket)
+	}
+
+	klog.Infof("Using S3 blob store at %s/%s.", endpoint, bucket)
+
+	return &S3BlobStore{client: client, bucket: bucket}, nil
+}
+
+// Put implements BlobStore.Put.
+func (s *S3BlobStore) Put(ctx context.Context, key string, r io.Reader, contentType string) error {
+	if _, err := s.client.PutObject(ctx, s.bucket, key, r, -1,
+		minio.PutObjectOptions{ContentType: contentType, PartSize: s3PartSize}); err != nil {
+		return fmt.Errorf("failed to put object: %w", err)
+	}
+
+	return nil
+}
+
+// Get implements BlobStore.Get.
+func (s *S3BlobStore) Get(ctx context.Context, key string, offset int64) (io.ReadCloser, error) {
+	object, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+
+	// GetObject is lazy; stat the object so a missing key is reported here.
+	info, err := object.Stat()
+	if err != nil {
+		object.Close()
+
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, fmt.Errorf("%w: %s", errBlobNotFound, key)
+		}
+
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+
+	// S3 rejects a range starting at the end, where the local store reads nothing.
+	if offset >= info.Size {
+		object.Close()
+		return io.NopCloser(strings.NewReader("")), nil
+	}
+
+	// a range set on the request would be dropped by the stat; seeking makes the first read
+	// request the content from the offset.
+	if _, err := object.Seek(offset, io.SeekStart); err != nil {
+		object.Close()
+		return nil, fmt.Errorf("failed to seek object: %w", err)
+	}
+
+	return object, nil
+}
+
+// Delete implements BlobStore.Delete.
+func (s *S3BlobStore) Delete(ctx context.Context, key string) error {
+	if err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("failed to remove object: %w", err)
+	}
+
+	return nil
+}
+
+// newBlobKey returns a fresh, unique key for storing file content.
+func newBlobKey() string {
+	return "files/" + uuid.NewString()
+}
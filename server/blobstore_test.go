@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// fakeS3 is an in-memory stand-in for the subset of the S3 API used by S3BlobStore,
+// answering unsigned path-style requests.
+type fakeS3 struct {
+	mu      sync.Mutex
+	buckets map[string]bool
+	objects map[string][]byte
+	// uploads holds the parts of multipart uploads in progress, by upload ID and part number.
+	uploads map[string]map[int][]byte
+	nextID  int
+}
+
+func newFakeS3(t *testing.T) string {
+	t.Helper()
+
+	fake := &fakeS3{
+		buckets: map[string]bool{},
+		objects: map[string][]byte{},
+		uploads: map[string]map[int][]byte{},
+	}
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	return strings.TrimPrefix(server.URL, "http://")
+}
+
+// s3Error writes an S3 error response.
+func s3Error(w http.ResponseWriter, code int, errCode string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(code)
+	fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", errCode, errCode)
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+
+	if key == "" {
+		f.serveBucket(w, r, bucket)
+		return
+	}
+
+	if !f.buckets[bucket] {
+		s3Error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+
+	name := bucket + "/" + key
+
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		f.nextID++
+		id := strconv.Itoa(f.nextID)
+		f.uploads[id] = map[int][]byte{}
+		fmt.Fprintf(w, "<InitiateMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key>"+
+			"<UploadId>%s</UploadId></InitiateMultipartUploadResult>", bucket, key, id)
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		parts, ok := f.uploads[query.Get("uploadId")]
+		if !ok {
+			s3Error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		parts[number], _ = io.ReadAll(r.Body)
+		w.Header().Set("ETag", fmt.Sprintf(`"part-%d"`, number))
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		parts, ok := f.uploads[query.Get("uploadId")]
+		if !ok {
+			s3Error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+
+		numbers := make([]int, 0, len(parts))
+		for number := range parts {
+			numbers = append(numbers, number)
+		}
+
+		sort.Ints(numbers)
+
+		var content []byte
+		for _, number := range numbers {
+			content = append(content, parts[number]...)
+		}
+
+		f.objects[name] = content
+		delete(f.uploads, query.Get("uploadId"))
+		fmt.Fprintf(w, `<CompleteMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key>`+
+			`<ETag>"object"</ETag></CompleteMultipartUploadResult>`, bucket, key)
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		delete(f.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		f.serveObject(w, r, name)
+	case r.Method == http.MethodDelete:
+		delete(f.objects, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s3Error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+// serveBucket answers the bucket existence check and bucket creation.
+func (f *fakeS3) serveBucket(w http.ResponseWriter, r *http.Request, bucket string) {
+	switch r.Method {
+	case http.MethodHead:
+		if !f.buckets[bucket] {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case http.MethodPut:
+		f.buckets[bucket] = true
+	default:
+		s3Error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+// serveObject answers object reads, honoring "bytes=<offset>-" ranges like S3 does,
+// including 416 for a range starting at or past the end.
+func (f *fakeS3) serveObject(w http.ResponseWriter, r *http.Request, name string) {
+	content, ok := f.objects[name]
+	if !ok {
+		s3Error(w, http.StatusNotFound, "NoSuchKey")
+		return
+	}
+
+	status, offset := http.StatusOK, 0
+
+	if spec, ok := strings.CutPrefix(r.Header.Get("Range"), "bytes="); ok {
+		offset, _ = strconv.Atoi(strings.TrimSuffix(spec, "-"))
+		if offset >= len(content) {
+			s3Error(w, http.StatusRequestedRangeNotSatisfiable, "InvalidRange")
+			return
+		}
+
+		status = http.StatusPartialContent
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, len(content)-1, len(content)))
+	}
+
+	w.Header().Set("ETag", `"object"`)
+	w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)-offset))
+	w.WriteHeader(status)
+
+	if r.Method == http.MethodGet {
+		w.Write(content[offset:])
+	}
+}
+
+// readBlob reads the blob stored under key from the given offset.
+func readBlob(t *testing.T, store BlobStore, key string, offset int64) (string, error) {
+	t.Helper()
+
+	reader, err := store.Get(context.Background(), key, offset)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("failed to read blob %s: %v", key, err)
+	}
+
+	return string(content), nil
+}
+
+// testBlobStore checks the BlobStore contract shared by every implementation.
+func testBlobStore(t *testing.T, store BlobStore) {
+	t.Helper()
+
+	ctx := context.Background()
+	key := newBlobKey()
+
+	if err := store.Put(ctx, key, strings.NewReader("hello, world"), "text/plain"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	if got, err := readBlob(t, store, key, 0); err != nil || got != "hello, world" {
+		t.Fatalf("Get = %q, %v, want %q", got, err, "hello, world")
+	}
+
+	if got, err := readBlob(t, store, key, 7); err != nil || got != "world" {
+		t.Fatalf("Get at offset 7 = %q, %v, want %q", got, err, "world")
+	}
+
+	if got, err := readBlob(t, store, key, int64(len("hello, world"))); err != nil || got != "" {
+		t.Fatalf("Get at the end = %q, %v, want no content", got, err)
+	}
+
+	if _, err := readBlob(t, store, newBlobKey(), 0); !errors.Is(err, errBlobNotFound) {
+		t.Fatalf("Get of a missing blob returned %v, want errBlobNotFound", err)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if _, err := readBlob(t, store, key, 0); !errors.Is(err, errBlobNotFound) {
+		t.Fatalf("Get of a deleted blob returned %v, want errBlobNotFound", err)
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete of a missing blob: %v", err)
+	}
+}
+
+func TestLocalBlobStore(t *testing.T) {
+	store, err := NewLocalBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalBlobStore: %v", err)
+	}
+
+	testBlobStore(t, store)
+
+	if err := store.Put(context.Background(), "../escape", strings.NewReader("x"), ""); err == nil {
+		t.Fatal("Put accepted a key outside the root directory")
+	}
+}
+
+func TestS3BlobStore(t *testing.T) {
+	endpoint := newFakeS3(t)
+
+	store, err := NewS3BlobStore(context.Background(), endpoint, "homeworks", &minio.Options{
+		Creds:  credentials.NewStaticV4("", "", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatalf("NewS3BlobStore: %v", err)
+	}
+
+	testBlobStore(t, store)
+
+	// a second store finds the existing bucket.
+	if _, err := NewS3BlobStore(context.Background(), endpoint, "homeworks", &minio.Options{
+		Creds:  credentials.NewStaticV4("", "", ""),
+		Region: "us-east-1",
+	}); err != nil {
+		t.Fatalf("NewS3BlobStore with an existing bucket: %v", err)
+	}
+}
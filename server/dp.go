@@ -6,20 +6,30 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
 	hpb "github.com/BetterGR/homework-microservice/protos"
+	ms "github.com/TekClinic/MicroService-Lib"
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/uptrace/bun/driver/pgdriver"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"k8s.io/klog/v2"
 )
 
+// likeEscaper escapes the wildcard characters of a LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 // Database represents the database connection.
 type Database struct {
 	db *bun.DB
 }
 
-// InitializeDatabase ensures that the database exists and initializes the schema.
+// InitializeDatabase ensures that the database exists and, unless AUTO_MIGRATE is false,
+// applies pending migrations.
 func InitializeDatabase() (*Database, error) {
 	createDatabaseIfNotExists()
 
@@ -28,8 +38,10 @@ func InitializeDatabase() (*Database, error) {
 		return nil, err
 	}
 
-	if err := database.createSchemaIfNotExists(context.Background()); err != nil {
-		klog.Fatalf("Failed to create schema: %v", err)
+	if ms.GetOptionalEnv("AUTO_MIGRATE", "true") == "true" {
+		if _, err := database.Migrate(context.Background()); err != nil {
+			return nil, fmt.Errorf("failed to migrate database: %w", err)
+		}
 	}
 
 	return database, nil
@@ -82,56 +94,423 @@ func ConnectDB() (*Database, error) {
 	return &Database{db: database}, nil
 }
 
-// createSchemaIfNotExists creates the database schema if it doesn't exist.
-func (d *Database) createSchemaIfNotExists(ctx context.Context) error {
-	models := []interface{}{
-		(*Homework)(nil),
+type Homework struct {
+	UniqueID     string      `bun:",pk,default:gen_random_uuid()"`
+	ID           string      `bun:"id,unique,notnull"`
+	CourseID     string      `bun:"course_id,notnull"`
+	Title        string      `bun:"title,notnull"`
+	Description  string      `bun:"description,notnull"`
+	Files        []*FileRef  `bun:"files,type:jsonb"`
+	Workflow     string      `bun:"workflow,notnull"`
+	DueDate      time.Time   `bun:"due_date,nullzero"`
+	CreatedAt    time.Time   `bun:"created_at,notnull,default:current_timestamp"`
+	LatePolicy   *LatePolicy `bun:"late_policy,type:jsonb"`
+	MaxAttempts  int32       `bun:"max_attempts,notnull,default:0"`
+	MaxGroupSize int32       `bun:"max_group_size,notnull,default:0"`
+	Rubric       *Rubric     `bun:"rubric,type:jsonb"`
+	// WorkflowSteps is nil when the homework has no workflow.
+	WorkflowSteps []string `bun:"workflow_steps,array"`
+	// Version increases with every update, guarding against concurrent edits.
+	Version int32 `bun:"version,notnull,default:1"`
+}
+
+// LatePolicy is the persisted form of a homework's late policy.
+type LatePolicy struct {
+	GracePeriod   time.Duration `json:"gracePeriod"`
+	PenaltyPerDay float64       `json:"penaltyPerDay"`
+	MaxPenalty    float64       `json:"maxPenalty"`
+	// HardCutoff is nil when late submissions are accepted indefinitely.
+	HardCutoff *time.Duration `json:"hardCutoff,omitempty"`
+}
+
+// newLatePolicy converts a late policy message into its persisted form.
+func newLatePolicy(policy *hpb.LatePolicy) *LatePolicy {
+	if policy == nil {
+		return nil
+	}
+
+	result := &LatePolicy{
+		GracePeriod:   policy.GetGracePeriod().AsDuration(),
+		PenaltyPerDay: policy.GetPenaltyPerDay(),
+		MaxPenalty:    policy.GetMaxPenalty(),
+	}
+
+	if policy.GetHardCutoff() != nil {
+		cutoff := policy.GetHardCutoff().AsDuration()
+		result.HardCutoff = &cutoff
+	}
+
+	return result
+}
+
+// toProto converts the persisted late policy into a message.
+func (p *LatePolicy) toProto() *hpb.LatePolicy {
+	if p == nil {
+		return nil
+	}
+
+	result := &hpb.LatePolicy{
+		GracePeriod:   durationpb.New(p.GracePeriod),
+		PenaltyPerDay: p.PenaltyPerDay,
+		MaxPenalty:    p.MaxPenalty,
+	}
+
+	if p.HardCutoff != nil {
+		result.HardCutoff = durationpb.New(*p.HardCutoff)
+	}
+
+	return result
+}
+
+// Rubric is the persisted form of a homework's rubric.
+type Rubric struct {
+	Criteria []RubricCriterion `json:"criteria"`
+}
+
+// RubricCriterion is the persisted form of a rubric criterion.
+type RubricCriterion struct {
+	ID          string        `json:"id"`
+	Title       string        `json:"title"`
+	Description string        `json:"description"`
+	Levels      []RubricLevel `json:"levels"`
+}
+
+// RubricLevel is the persisted form of a level of a rubric criterion.
+type RubricLevel struct {
+	ID          string  `json:"id"`
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	Points      float64 `json:"points"`
+}
+
+// newRubric converts a rubric message into its persisted form.
+func newRubric(rubric *hpb.Rubric) *Rubric {
+	if rubric == nil {
+		return nil
 	}
 
-	for _, model := range models {
-		if _, err := d.db.NewCreateTable().IfNotExists().Model(model).Exec(ctx); err != nil {
-			return fmt.Errorf("failed to create table: %w", err)
+	result := &Rubric{Criteria: make([]RubricCriterion, 0, len(rubric.GetCriteria()))}
+
+	for _, criterion := range rubric.GetCriteria() {
+		levels := make([]RubricLevel, 0, len(criterion.GetLevels()))
+
+		for _, level := range criterion.GetLevels() {
+			levels = append(levels, RubricLevel{
+				ID:          level.GetId(),
+				Title:       level.GetTitle(),
+				Description: level.GetDescription(),
+				Points:      level.GetPoints(),
+			})
 		}
+
+		result.Criteria = append(result.Criteria, RubricCriterion{
+			ID:          criterion.GetId(),
+			Title:       criterion.GetTitle(),
+			Description: criterion.GetDescription(),
+			Levels:      levels,
+		})
 	}
 
-	klog.Info("Database schema initialized.")
+	return result
+}
 
-	return nil
+// toProto converts the persisted rubric into a message.
+func (r *Rubric) toProto() *hpb.Rubric {
+	if r == nil {
+		return nil
+	}
+
+	result := &hpb.Rubric{Criteria: make([]*hpb.RubricCriterion, 0, len(r.Criteria))}
+
+	for _, criterion := range r.Criteria {
+		levels := make([]*hpb.RubricLevel, 0, len(criterion.Levels))
+
+		for _, level := range criterion.Levels {
+			levels = append(levels, &hpb.RubricLevel{
+				Id:          level.ID,
+				Title:       level.Title,
+				Description: level.Description,
+				Points:      level.Points,
+			})
+		}
+
+		result.Criteria = append(result.Criteria, &hpb.RubricCriterion{
+			Id:          criterion.ID,
+			Title:       criterion.Title,
+			Description: criterion.Description,
+			Levels:      levels,
+		})
+	}
+
+	return result
 }
 
-type Homework struct {
-	UniqueID    string            `bun:",pk,default:gen_random_uuid()"`
-	ID          string            `bun:"id,unique,notnull"`
-	CourseID    string            `bun:"course_id,notnull"`
-	Title       string            `bun:"title,notnull"`
-	Description string            `bun:"description,notnull"`
-	Files       []*hpb.File       `bun:"files,array"`
-	Workflow    string            `bun:"workflow,notnull"`
-	DueDate     string            `bun:"due_date,notnull"`
-	Submissions []*hpb.Submission `bun:"submissions,array"`
-}
-
-// AddHomework adds a homework to the database.
+// workflowSteps returns the persisted form of a workflow: its steps, or nil when unset.
+func workflowSteps(workflow *hpb.Workflow) []string {
+	if workflow == nil {
+		return nil
+	}
+
+	if workflow.GetSteps() == nil {
+		return []string{}
+	}
+
+	return workflow.GetSteps()
+}
+
+// newHomework converts a homework message into its database model.
+func newHomework(homework *hpb.Homework) *Homework {
+	return &Homework{
+		ID:            homework.GetId(),
+		CourseID:      homework.GetCourseId(),
+		Title:         homework.GetTitle(),
+		Description:   homework.GetDescription(),
+		Files:         newFileRefs(homework.GetFiles()),
+		Workflow:      homework.GetWorkflow(), //nolint:staticcheck // kept for older clients.
+		DueDate:       fromTimestamp(homework.GetDueDate()),
+		LatePolicy:    newLatePolicy(homework.GetLatePolicy()),
+		MaxAttempts:   homework.GetMaxAttempts(),
+		MaxGroupSize:  homework.GetMaxGroupSize(),
+		Rubric:        newRubric(homework.GetRubric()),
+		WorkflowSteps: workflowSteps(homework.GetWorkflowDefinition()),
+	}
+}
+
+// toProto converts the database model into a homework message, without submissions.
+// The message shares no memory with the model.
+func (h *Homework) toProto() *hpb.Homework {
+	homework := &hpb.Homework{
+		Id:           h.ID,
+		CourseId:     h.CourseID,
+		Title:        h.Title,
+		Description:  h.Description,
+		Files:        fileRefsToProto(h.Files),
+		Workflow:     h.Workflow,                  //nolint:staticcheck // filled in for older clients.
+		DueDateText:  formatLegacyTime(h.DueDate), //nolint:staticcheck // filled in for older clients.
+		DueDate:      toTimestamp(h.DueDate),
+		CreatedAt:    toTimestamp(h.CreatedAt),
+		LatePolicy:   h.LatePolicy.toProto(),
+		MaxAttempts:  h.MaxAttempts,
+		MaxGroupSize: h.MaxGroupSize,
+		Rubric:       h.Rubric.toProto(),
+		Version:      h.Version,
+	}
+
+	if h.WorkflowSteps != nil {
+		homework.WorkflowDefinition = &hpb.Workflow{Steps: slices.Clone(h.WorkflowSteps)}
+	}
+
+	return homework
+}
+
+// HomeworkFilter selects, orders and pages the homeworks returned by ListHomeworks.
+type HomeworkFilter struct {
+	CourseID      string
+	DueAfter      time.Time
+	DueBefore     time.Time
+	TitleContains string
+	OrderBy       hpb.HomeworkOrder
+	Descending    bool
+	Limit         int
+	// After resumes the listing after the homework it points at.
+	After *HomeworkCursor
+}
+
+// HomeworkCursor points at a listed homework by its sort key and ID. Key is empty when
+// Undated marks a homework without a due date listed by due date.
+type HomeworkCursor struct {
+	Key     string `json:"k"`
+	ID      string `json:"i"`
+	Undated bool   `json:"u,omitempty"`
+}
+
+// FileRef is the persisted form of a file: its metadata and a reference to its
+// content in the blob store.
+type FileRef struct {
+	Filename   string `json:"filename"`
+	MimeType   string `json:"mimeType"`
+	ContentRef string `json:"contentRef"`
+	SHA256     string `json:"sha256"`
+	Size       int64  `json:"size"`
+}
+
+// StoredFile records a blob written to the blob store and the homework it belongs to.
+type StoredFile struct {
+	ContentRef string    `bun:"content_ref,pk"`
+	HomeworkID string    `bun:"homework_id,notnull"`
+	UploadedBy string    `bun:"uploaded_by,notnull"`
+	Filename   string    `bun:"filename,notnull"`
+	MimeType   string    `bun:"mime_type,notnull"`
+	SHA256     string    `bun:"sha256,notnull"`
+	Size       int64     `bun:"size,notnull"`
+	CreatedAt  time.Time `bun:"created_at,notnull,default:current_timestamp"`
+}
+
+// fileRef returns the metadata of the stored blob.
+func (f *StoredFile) fileRef() *FileRef {
+	return &FileRef{
+		Filename:   f.Filename,
+		MimeType:   f.MimeType,
+		ContentRef: f.ContentRef,
+		SHA256:     f.SHA256,
+		Size:       f.Size,
+	}
+}
+
+// newFileRef converts a file message into its persisted form, dropping any inline content.
+func newFileRef(file *hpb.File) *FileRef {
+	if file == nil {
+		return nil
+	}
+
+	return &FileRef{
+		Filename:   file.GetFilename(),
+		MimeType:   file.GetMimeType(),
+		ContentRef: file.GetContentRef(),
+		SHA256:     file.GetSha256(),
+		Size:       file.GetSize(),
+	}
+}
+
+// newFileRefs converts a list of file messages into their persisted form.
+func newFileRefs(files []*hpb.File) []*FileRef {
+	refs := make([]*FileRef, 0, len(files))
+
+	for _, file := range files {
+		refs = append(refs, newFileRef(file))
+	}
+
+	return refs
+}
+
+// toProto converts the persisted file into a file message without content.
+func (f *FileRef) toProto() *hpb.File {
+	if f == nil {
+		return nil
+	}
+
+	return &hpb.File{
+		Filename:   f.Filename,
+		MimeType:   f.MimeType,
+		ContentRef: f.ContentRef,
+		Sha256:     f.SHA256,
+		Size:       f.Size,
+	}
+}
+
+// fileRefsToProto converts a list of persisted files into file messages.
+func fileRefsToProto(refs []*FileRef) []*hpb.File {
+	files := make([]*hpb.File, 0, len(refs))
+
+	for _, ref := range refs {
+		files = append(files, ref.toProto())
+	}
+
+	return files
+}
+
+var (
+	// errStaleVersion is returned when a homework changed since the version an update is based on.
+	errStaleVersion = errors.New("homework was modified concurrently")
+	// errMaxAttemptsReached is returned when a student or group has used up the submission
+	// attempts of a homework.
+	errMaxAttemptsReached = errors.New("maximum number of attempts reached")
+	// errGroupConflict is returned when declared partners contradict the existing groups of a homework.
+	errGroupConflict = errors.New("inconsistent group")
+	// errNotGraded is returned when a regrade is requested on a submission without a grade.
+	errNotGraded = errors.New("submission is not graded")
+	// errRegradeOpen is returned when a submission already has an open regrade request.
+	errRegradeOpen = errors.New("regrade request already open")
+	// errRegradeResolved is returned when resolving a regrade request that is no longer open.
+	errRegradeResolved = errors.New("regrade request already resolved")
+	// errStepOutOfOrder is returned when a workflow step is completed before the steps preceding it.
+	errStepOutOfOrder = errors.New("workflow step out of order")
+	// errWorkflowDone is returned when advancing a workflow whose steps are all completed.
+	errWorkflowDone = errors.New("workflow already completed")
+)
+
+// Submission is a single version of a student's or group's submission, stored apart from
+// its homework. Versions are immutable; only the final flag moves between them.
+type Submission struct {
+	ID         string `bun:"id,pk,default:gen_random_uuid()"`
+	HomeworkID string `bun:"homework_id,notnull,unique:submissions_homework_id_owner_id_version"`
+	StudentID  string `bun:"student_id,notnull"`
+	// GroupID is empty for individual submissions.
+	GroupID string `bun:"group_id,nullzero"`
+	// OwnerID is the group ID, or the student ID for individual submissions; versions are
+	// numbered per owner.
+	OwnerID        string        `bun:"owner_id,notnull,unique:submissions_homework_id_owner_id_version"`
+	Version        int32         `bun:"version,notnull,unique:submissions_homework_id_owner_id_version"`
+	Final          bool          `bun:"final,notnull,default:false"`
+	SubmissionTime time.Time     `bun:"submission_time,nullzero"`
+	SubmissionFile *FileRef      `bun:"submission_file,type:jsonb"`
+	PartnersID     []string      `bun:"partners_id,array"`
+	IsLate         bool          `bun:"is_late,notnull,default:false"`
+	LateBy         time.Duration `bun:"late_by,notnull,default:0"`
+	PenaltyPercent float64       `bun:"penalty_percent,notnull,default:0"`
+
+	Homework *Homework `bun:"rel:belongs-to,join:homework_id=id,on_delete:CASCADE"`
+}
+
+// newSubmission converts a submission message into its database model.
+func newSubmission(homeworkID string, submission *hpb.Submission) *Submission {
+	owner := submission.GetGroupId()
+	if owner == "" {
+		owner = submission.GetStudentId()
+	}
+
+	return &Submission{
+		HomeworkID:     homeworkID,
+		StudentID:      submission.GetStudentId(),
+		GroupID:        submission.GetGroupId(),
+		OwnerID:        owner,
+		SubmissionTime: fromTimestamp(submission.GetSubmissionTime()),
+		SubmissionFile: newFileRef(submission.GetSubmissionFile()),
+		PartnersID:     submission.GetPartnersId(),
+		IsLate:         submission.GetIsLate(),
+		LateBy:         submission.GetLateBy().AsDuration(),
+		PenaltyPercent: submission.GetPenaltyPercent(),
+		Version:        submission.GetVersion(),
+		Final:          submission.GetFinal(),
+	}
+}
+
+// toProto converts the database model into a submission message that shares no memory with it.
+func (s *Submission) toProto() *hpb.Submission {
+	return &hpb.Submission{
+		Id:                 s.ID,
+		HomeworkId:         s.HomeworkID,
+		StudentId:          s.StudentID,
+		SubmissionTime:     toTimestamp(s.SubmissionTime),
+		SubmissionTimeText: formatLegacyTime(s.SubmissionTime), //nolint:staticcheck // filled in for older clients.
+		SubmissionFile:     s.SubmissionFile.toProto(),
+		PartnersId:         slices.Clone(s.PartnersID),
+		IsLate:             s.IsLate,
+		LateBy:             durationpb.New(s.LateBy),
+		PenaltyPercent:     s.PenaltyPercent,
+		Version:            s.Version,
+		Final:              s.Final,
+		GroupId:            s.GroupID,
+	}
+}
+
+// AddHomework adds a homework to the database at version 1.
 func (d *Database) AddHomework(ctx context.Context, homework *hpb.Homework) error {
-	if _, err := d.db.NewInsert().Model(&Homework{
-		ID:          homework.GetId(),
-		CourseID:    homework.GetCourseId(),
-		Title:       homework.GetTitle(),
-		Description: homework.GetDescription(),
-		Files:       homework.GetFiles(),
-		Workflow:    homework.GetWorkflow(),
-		DueDate:     homework.GetDueDate(),
-		Submissions: homework.GetSubmissions(),
-	}).Exec(ctx); err != nil {
+	model := newHomework(homework)
+	model.Version = 1
+
+	if _, err := d.db.NewInsert().Model(model).Exec(ctx); err != nil {
 		return fmt.Errorf("failed to insert homework: %w", err)
 	}
 
+	homework.Version = model.Version
+
 	klog.Info("Homework added successfully.")
 
 	return nil
 }
 
-// GetHomework retrieves a homework by ID from the database.
+// GetHomework retrieves a homework by ID from the database, without its submissions.
 func (d *Database) GetHomework(ctx context.Context, id string) (*hpb.Homework, error) {
 	homework := new(Homework)
 
@@ -139,47 +518,1095 @@ func (d *Database) GetHomework(ctx context.Context, id string) (*hpb.Homework, e
 		return nil, fmt.Errorf("failed to get homework: %w", err)
 	}
 
-	return &hpb.Homework{
-		Id:          homework.ID,
-		CourseId:    homework.CourseID,
-		Title:       homework.Title,
-		Description: homework.Description,
-		Files:       homework.Files,
-		Workflow:    homework.Workflow,
-		DueDate:     homework.DueDate,
-		Submissions: homework.Submissions,
-	}, nil
-}
-
-// UpdateHomework updates an existing homework in the database.
-func (d *Database) UpdateHomework(ctx context.Context, homework *hpb.Homework) error {
-	_, err := d.db.NewUpdate().Model(&Homework{
-		ID:          homework.GetId(),
-		CourseID:    homework.GetCourseId(),
-		Title:       homework.GetTitle(),
-		Description: homework.GetDescription(),
-		Files:       homework.GetFiles(),
-		Workflow:    homework.GetWorkflow(),
-		DueDate:     homework.GetDueDate(),
-		Submissions: homework.GetSubmissions(),
-	}).Where("id = ?", homework.GetId()).Exec(ctx)
+	return homework.toProto(), nil
+}
+
+// ListHomeworks retrieves one page of a course's homeworks matching the filter.
+// It returns a cursor to the last homework of the page, or nil when no homeworks follow it.
+func (d *Database) ListHomeworks(ctx context.Context,
+	filter *HomeworkFilter,
+) ([]*hpb.Homework, *HomeworkCursor, error) {
+	var homeworks []Homework
+
+	query := d.db.NewSelect().Model(&homeworks).Where("course_id = ?", filter.CourseID)
+
+	if !filter.DueAfter.IsZero() {
+		query = query.Where("due_date >= ?", filter.DueAfter)
+	}
+
+	if !filter.DueBefore.IsZero() {
+		query = query.Where("due_date < ?", filter.DueBefore)
+	}
+
+	if filter.TitleContains != "" {
+		query = query.Where("title ILIKE ?", "%"+likeEscaper.Replace(filter.TitleContains)+"%")
+	}
+
+	byCreation := filter.OrderBy == hpb.HomeworkOrder_HOMEWORK_ORDER_CREATED_AT
+
+	direction, comparison := "ASC", ">"
+	if filter.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	// undated homeworks are listed last in either direction, so the due date is ordered
+	// after the leading due_date IS NULL key.
+	switch after := filter.After; {
+	case after == nil:
+	case byCreation:
+		query = query.Where("(created_at, id) "+comparison+" (?::timestamptz, ?)", after.Key, after.ID)
+	case after.Undated:
+		query = query.Where("due_date IS NULL AND id "+comparison+" ?", after.ID)
+	default:
+		query = query.Where("(due_date IS NULL OR (due_date, id) "+comparison+" (?::timestamptz, ?))",
+			after.Key, after.ID)
+	}
+
+	if byCreation {
+		query = query.OrderExpr("created_at " + direction + ", id " + direction)
+	} else {
+		query = query.OrderExpr("due_date IS NULL, due_date " + direction + ", id " + direction)
+	}
+
+	// fetch one extra row to learn whether another page follows.
+	if err := query.Limit(filter.Limit + 1).Scan(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to list homeworks: %w", err)
+	}
+
+	var next *HomeworkCursor
+
+	if len(homeworks) > filter.Limit {
+		homeworks = homeworks[:filter.Limit]
+		last := homeworks[len(homeworks)-1]
+		next = &HomeworkCursor{ID: last.ID}
+
+		switch {
+		case byCreation:
+			next.Key = last.CreatedAt.Format(time.RFC3339Nano)
+		case last.DueDate.IsZero():
+			next.Undated = true
+		default:
+			next.Key = last.DueDate.Format(time.RFC3339Nano)
+		}
+	}
+
+	result := make([]*hpb.Homework, 0, len(homeworks))
+	for i := range homeworks {
+		result = append(result, homeworks[i].toProto())
+	}
+
+	return result, next, nil
+}
+
+// UpdateHomework updates an existing homework in the database, provided it is still at the
+// homework's version, and returns the stored row at its new version. Only the given columns are
+// written, or all of them when columns is nil. Submissions are stored separately and are left untouched.
+func (d *Database) UpdateHomework(ctx context.Context, homework *hpb.Homework,
+	columns []string,
+) (*hpb.Homework, error) {
+	model := newHomework(homework)
+	model.Version = homework.GetVersion() + 1
+
+	query := d.db.NewUpdate().Model(model)
+	if columns == nil {
+		// the creation time is kept.
+		query = query.ExcludeColumn("created_at")
+	} else {
+		query = query.Column(append(slices.Clone(columns), "version")...)
+	}
+
+	// only the version the caller read is replaced; the whole stored row is read back into the model.
+	err := query.Where("id = ?", homework.GetId()).Where("version = ?", homework.GetVersion()).
+		Returning("*").Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		exists, err := d.db.NewSelect().Model((*Homework)(nil)).Where("id = ?", homework.GetId()).Exists(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get homework: %w", err)
+		}
+
+		if !exists {
+			return nil, fmt.Errorf("failed to update homework: %w", sql.ErrNoRows)
+		}
+
+		return nil, fmt.Errorf("%w: version %d is no longer current", errStaleVersion, homework.GetVersion())
+	}
+
 	if err != nil {
-		return fmt.Errorf("failed to update homework: %w", err)
+		return nil, fmt.Errorf("failed to update homework: %w", err)
 	}
 
 	klog.Info("Homework updated successfully.")
 
-	return nil
+	return model.toProto(), nil
 }
 
-// DeleteHomework removes a homework from the database.
-func (d *Database) DeleteHomework(ctx context.Context, id string) error {
-	_, err := d.db.NewDelete().Model((*Homework)(nil)).Where("id = ?", id).Exec(ctx)
+// DeleteHomework removes a homework, and through the cascade its submissions, from the database.
+// It returns the content references of the homework's stored files so their blobs can be removed.
+func (d *Database) DeleteHomework(ctx context.Context, id string) ([]string, error) {
+	var contentRefs []string
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		result, err := tx.NewDelete().Model((*Homework)(nil)).Where("id = ?", id).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete homework: %w", err)
+		}
+
+		if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+			return fmt.Errorf("failed to delete homework: %w", sql.ErrNoRows)
+		}
+
+		if err := tx.NewDelete().Model((*StoredFile)(nil)).Where("homework_id = ?", id).
+			Returning("content_ref").Scan(ctx, &contentRefs); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to delete stored files: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to delete homework: %w", err)
+		return nil, err
 	}
 
 	klog.Info("Homework deleted successfully.")
 
+	return contentRefs, nil
+}
+
+// AddStoredFile records a blob stored for the homework with the given ID by the given uploader.
+func (d *Database) AddStoredFile(ctx context.Context, homeworkID, uploadedBy string, ref *FileRef) error {
+	if _, err := d.db.NewInsert().Model(&StoredFile{
+		ContentRef: ref.ContentRef,
+		HomeworkID: homeworkID,
+		UploadedBy: uploadedBy,
+		Filename:   ref.Filename,
+		MimeType:   ref.MimeType,
+		SHA256:     ref.SHA256,
+		Size:       ref.Size,
+	}).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to insert stored file: %w", err)
+	}
+
+	return nil
+}
+
+// GetStoredFile retrieves the record of a stored blob by its content reference.
+func (d *Database) GetStoredFile(ctx context.Context, contentRef string) (*StoredFile, error) {
+	file := new(StoredFile)
+
+	if err := d.db.NewSelect().Model(file).Where("content_ref = ?", contentRef).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get stored file: %w", err)
+	}
+
+	return file, nil
+}
+
+// DeleteStoredFiles removes the records of the stored blobs with the given content references.
+func (d *Database) DeleteStoredFiles(ctx context.Context, contentRefs []string) error {
+	if _, err := d.db.NewDelete().Model((*StoredFile)(nil)).Where("content_ref IN (?)", bun.In(contentRefs)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete stored files: %w", err)
+	}
+
+	return nil
+}
+
+// insertSubmission inserts a single submission row using the given database handle.
+func insertSubmission(ctx context.Context, db bun.IDB, homeworkID string, submission *hpb.Submission) error {
+	model := newSubmission(homeworkID, submission)
+
+	if _, err := db.NewInsert().Model(model).Returning("id").Exec(ctx); err != nil {
+		return fmt.Errorf("failed to insert submission: %w", err)
+	}
+
+	submission.Id = model.ID
+	submission.HomeworkId = homeworkID
+
+	return nil
+}
+
+// GroupMember records a student's membership in a submission group of a homework.
+type GroupMember struct {
+	ID         string `bun:"id,pk,default:gen_random_uuid()"`
+	HomeworkID string `bun:"homework_id,notnull,unique:group_members_homework_id_student_id"`
+	StudentID  string `bun:"student_id,notnull,unique:group_members_homework_id_student_id"`
+	GroupID    string `bun:"group_id,notnull"`
+
+	Homework *Homework `bun:"rel:belongs-to,join:homework_id=id,on_delete:CASCADE"`
+}
+
+// lockSubmissions serializes, until the end of the transaction, changes to the
+// submissions and groups of the homework with the given ID.
+func lockSubmissions(ctx context.Context, tx bun.Tx, homeworkID string) error {
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext(?))", homeworkID); err != nil {
+		return fmt.Errorf("failed to lock submissions: %w", err)
+	}
+
+	return nil
+}
+
+// clearFinal unmarks the final submission of a student or group for the homework with the given ID.
+func clearFinal(ctx context.Context, tx bun.Tx, homeworkID, ownerID string) error {
+	if _, err := tx.NewUpdate().Model((*Submission)(nil)).Set("final = false").
+		Where("homework_id = ?", homeworkID).Where("owner_id = ?", ownerID).
+		Where("final").Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update final submission: %w", err)
+	}
+
 	return nil
 }
+
+// groupOf returns the members of a group, sorted.
+func groupOf(ctx context.Context, db bun.IDB, groupID string) ([]string, error) {
+	var members []string
+
+	if err := db.NewSelect().Model((*GroupMember)(nil)).Column("student_id").
+		Where("group_id = ?", groupID).Order("student_id").Scan(ctx, &members); err != nil {
+		return nil, fmt.Errorf("failed to get group members: %w", err)
+	}
+
+	return members, nil
+}
+
+// resolveGroup determines the group a student submits for, forming it on the first submission
+// that declares partners. Declared partners must match an existing group exactly; declaring none
+// submits for the student's group, if any. It returns the group's ID and the submitter's partners,
+// or an empty ID for individual submissions.
+func resolveGroup(ctx context.Context, tx bun.Tx, homeworkID, studentID string,
+	partners []string,
+) (string, []string, error) {
+	members := append([]string{studentID}, partners...)
+
+	var memberships []GroupMember
+
+	if err := tx.NewSelect().Model(&memberships).Where("homework_id = ?", homeworkID).
+		Where("student_id IN (?)", bun.In(members)).Scan(ctx); err != nil {
+		return "", nil, fmt.Errorf("failed to get group memberships: %w", err)
+	}
+
+	if len(memberships) == 0 {
+		if len(partners) == 0 {
+			return "", nil, nil
+		}
+
+		groupID, err := formGroup(ctx, tx, homeworkID, members)
+
+		return groupID, partners, err
+	}
+
+	groupID := ""
+
+	for _, membership := range memberships {
+		if membership.StudentID == studentID {
+			groupID = membership.GroupID
+		}
+	}
+
+	for _, membership := range memberships {
+		if membership.GroupID != groupID {
+			return "", nil, fmt.Errorf("%w: student %s already belongs to another group",
+				errGroupConflict, membership.StudentID)
+		}
+	}
+
+	group, err := groupOf(ctx, tx, groupID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(partners) > 0 && (len(memberships) != len(members) || len(group) != len(members)) {
+		return "", nil, fmt.Errorf("%w: partners do not match the members of group %s",
+			errGroupConflict, groupID)
+	}
+
+	others := make([]string, 0, len(group)-1)
+
+	for _, member := range group {
+		if member != studentID {
+			others = append(others, member)
+		}
+	}
+
+	return groupID, others, nil
+}
+
+// formGroup records a new group of the given students for the homework with the given ID.
+// Students who already submitted individually cannot join a group.
+func formGroup(ctx context.Context, tx bun.Tx, homeworkID string, members []string) (string, error) {
+	var individual []string
+
+	if err := tx.NewSelect().Model((*Submission)(nil)).Column("student_id").
+		Where("homework_id = ?", homeworkID).Where("group_id IS NULL").
+		Where("student_id IN (?)", bun.In(members)).Limit(1).Scan(ctx, &individual); err != nil {
+		return "", fmt.Errorf("failed to get individual submissions: %w", err)
+	}
+
+	if len(individual) > 0 {
+		return "", fmt.Errorf("%w: student %s already submitted individually", errGroupConflict, individual[0])
+	}
+
+	groupID := uuid.NewString()
+	rows := make([]GroupMember, 0, len(members))
+
+	for _, member := range members {
+		rows = append(rows, GroupMember{HomeworkID: homeworkID, StudentID: member, GroupID: groupID})
+	}
+
+	if _, err := tx.NewInsert().Model(&rows).Exec(ctx); err != nil {
+		return "", fmt.Errorf("failed to insert group members: %w", err)
+	}
+
+	return groupID, nil
+}
+
+// AddSubmission inserts a submission as the next version of the student's, or their group's,
+// submissions for the homework with the given ID and marks it final. The submission's partners
+// are checked against, and may form, the homework's groups. maxAttempts limits the number of
+// versions; 0 means unlimited.
+func (d *Database) AddSubmission(ctx context.Context, homeworkID string,
+	submission *hpb.Submission, maxAttempts int32,
+) error {
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		exists, err := tx.NewSelect().Model((*Homework)(nil)).Where("id = ?", homeworkID).Exists(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get homework: %w", err)
+		}
+
+		if !exists {
+			return fmt.Errorf("failed to get homework: %w", sql.ErrNoRows)
+		}
+
+		if err := lockSubmissions(ctx, tx, homeworkID); err != nil {
+			return err
+		}
+
+		groupID, partners, err := resolveGroup(ctx, tx, homeworkID, submission.GetStudentId(),
+			submission.GetPartnersId())
+		if err != nil {
+			return err
+		}
+
+		submission.GroupId = groupID
+		submission.PartnersId = partners
+		model := newSubmission(homeworkID, submission)
+
+		var latest int32
+
+		if err := tx.NewSelect().Model((*Submission)(nil)).ColumnExpr("COALESCE(MAX(version), 0)").
+			Where("homework_id = ?", homeworkID).Where("owner_id = ?", model.OwnerID).
+			Scan(ctx, &latest); err != nil {
+			return fmt.Errorf("failed to get latest version: %w", err)
+		}
+
+		if maxAttempts > 0 && latest >= maxAttempts {
+			return fmt.Errorf("%w: %d of %d used", errMaxAttemptsReached, latest, maxAttempts)
+		}
+
+		if err := clearFinal(ctx, tx, homeworkID, model.OwnerID); err != nil {
+			return err
+		}
+
+		submission.Version = latest + 1
+		submission.Final = true
+
+		return insertSubmission(ctx, tx, homeworkID, submission)
+	})
+	if err != nil {
+		return err
+	}
+
+	klog.Info("Submission added successfully.")
+
+	return nil
+}
+
+// GroupMembers returns the members of the student's group for the homework with the given ID,
+// the student included, or nil when the student is not in a group.
+func (d *Database) GroupMembers(ctx context.Context, homeworkID, studentID string) ([]string, error) {
+	var groupIDs []string
+
+	if err := d.db.NewSelect().Model((*GroupMember)(nil)).Column("group_id").
+		Where("homework_id = ?", homeworkID).Where("student_id = ?", studentID).
+		Scan(ctx, &groupIDs); err != nil {
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+
+	return groupOf(ctx, d.db, groupIDs[0])
+}
+
+// GetSubmission retrieves a single submission version by ID.
+func (d *Database) GetSubmission(ctx context.Context, id string) (*hpb.Submission, error) {
+	submission := new(Submission)
+
+	if err := d.db.NewSelect().Model(submission).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get submission: %w", err)
+	}
+
+	return submission.toProto(), nil
+}
+
+// SelectFinalSubmission marks the submission version with the given ID as final,
+// unmarking the previously final version of the same student or group.
+func (d *Database) SelectFinalSubmission(ctx context.Context, id string) (*hpb.Submission, error) {
+	submission := new(Submission)
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := tx.NewSelect().Model(submission).Where("id = ?", id).Scan(ctx); err != nil {
+			return fmt.Errorf("failed to get submission: %w", err)
+		}
+
+		if err := lockSubmissions(ctx, tx, submission.HomeworkID); err != nil {
+			return err
+		}
+
+		if err := clearFinal(ctx, tx, submission.HomeworkID, submission.OwnerID); err != nil {
+			return err
+		}
+
+		if _, err := tx.NewUpdate().Model(submission).Set("final = true").
+			WherePK().Returning("*").Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update final submission: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	klog.Info("Final submission selected successfully.")
+
+	return submission.toProto(), nil
+}
+
+// GetSubmissions retrieves the final submissions of the homework with the given ID. A non-empty
+// studentID limits the result to the submissions made by the student or by their group.
+func (d *Database) GetSubmissions(ctx context.Context, homeworkID, studentID string) ([]*hpb.Submission, error) {
+	var submissions []Submission
+
+	query := d.db.NewSelect().Model(&submissions).Where("homework_id = ?", homeworkID).Where("final")
+	if studentID != "" {
+		query = query.Where("student_id = ? OR group_id IN (?)", studentID, d.studentGroups(studentID))
+	}
+
+	if err := query.Order("submission_time").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get submissions: %w", err)
+	}
+
+	return submissionsToProto(submissions), nil
+}
+
+// HasSubmissionFile reports whether any version submitted by the student, or by their group,
+// for the homework with the given ID holds the file with the given content reference.
+func (d *Database) HasSubmissionFile(ctx context.Context, homeworkID, studentID, contentRef string) (bool, error) {
+	exists, err := d.db.NewSelect().Model((*Submission)(nil)).Where("homework_id = ?", homeworkID).
+		Where("student_id = ? OR group_id IN (?)", studentID, d.studentGroups(studentID)).
+		Where("submission_file->>'contentRef' = ?", contentRef).Exists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get submission file: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetStudentSubmissions retrieves the final submissions of the given student across all homeworks,
+// including those made by other members of the student's groups. A non-nil courseIDs limits the
+// result to homeworks of those courses.
+func (d *Database) GetStudentSubmissions(ctx context.Context, studentID string,
+	courseIDs []string,
+) ([]*hpb.Submission, error) {
+	var submissions []Submission
+
+	query := d.db.NewSelect().Model(&submissions).
+		Where("student_id = ? OR group_id IN (?)", studentID, d.studentGroups(studentID)).
+		Where("final")
+	if courseIDs != nil {
+		query = query.Where("homework_id IN (?)", d.courseHomeworks(courseIDs))
+	}
+
+	if err := query.Order("homework_id", "submission_time").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get submissions: %w", err)
+	}
+
+	return submissionsToProto(submissions), nil
+}
+
+// studentGroups selects the IDs of the groups the student belongs to.
+func (d *Database) studentGroups(studentID string) *bun.SelectQuery {
+	return d.db.NewSelect().Model((*GroupMember)(nil)).Column("group_id").Where("student_id = ?", studentID)
+}
+
+// courseHomeworks selects the IDs of the homeworks of the given courses.
+func (d *Database) courseHomeworks(courseIDs []string) *bun.SelectQuery {
+	return d.db.NewSelect().Model((*Homework)(nil)).Column("id").Where("course_id IN (?)", bun.In(courseIDs))
+}
+
+// ListSubmissionVersions retrieves every version a student, or their group, submitted for the
+// homework with the given ID, oldest first.
+func (d *Database) ListSubmissionVersions(ctx context.Context,
+	homeworkID, studentID string,
+) ([]*hpb.Submission, error) {
+	var submissions []Submission
+
+	group := d.db.NewSelect().Model((*GroupMember)(nil)).Column("group_id").
+		Where("homework_id = ?", homeworkID).Where("student_id = ?", studentID)
+
+	if err := d.db.NewSelect().Model(&submissions).Where("homework_id = ?", homeworkID).
+		Where("owner_id = COALESCE((?), ?)", group, studentID).Order("version").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list submission versions: %w", err)
+	}
+
+	return submissionsToProto(submissions), nil
+}
+
+// submissionsToProto converts a list of database submissions into messages.
+func submissionsToProto(submissions []Submission) []*hpb.Submission {
+	result := make([]*hpb.Submission, 0, len(submissions))
+
+	for i := range submissions {
+		result = append(result, submissions[i].toProto())
+	}
+
+	return result
+}
+
+// Extension is a student's individual due date for a homework.
+type Extension struct {
+	ID         string    `bun:"id,pk,default:gen_random_uuid()"`
+	HomeworkID string    `bun:"homework_id,notnull,unique:extensions_homework_id_student_id"`
+	StudentID  string    `bun:"student_id,notnull,unique:extensions_homework_id_student_id"`
+	DueDate    time.Time `bun:"due_date,notnull"`
+	Reason     string    `bun:"reason,notnull"`
+	GrantedAt  time.Time `bun:"granted_at,notnull,default:current_timestamp"`
+
+	Homework *Homework `bun:"rel:belongs-to,join:homework_id=id,on_delete:CASCADE"`
+}
+
+// toProto converts the database model into an extension message.
+func (e *Extension) toProto() *hpb.Extension {
+	return &hpb.Extension{
+		HomeworkId: e.HomeworkID,
+		StudentId:  e.StudentID,
+		DueDate:    toTimestamp(e.DueDate),
+		Reason:     e.Reason,
+		GrantedAt:  toTimestamp(e.GrantedAt),
+	}
+}
+
+// GrantExtensions inserts the given extensions, replacing existing ones of the same students.
+func (d *Database) GrantExtensions(ctx context.Context, extensions []*hpb.Extension) ([]*hpb.Extension, error) {
+	models := make([]Extension, 0, len(extensions))
+
+	for _, extension := range extensions {
+		models = append(models, Extension{
+			HomeworkID: extension.GetHomeworkId(),
+			StudentID:  extension.GetStudentId(),
+			DueDate:    fromTimestamp(extension.GetDueDate()),
+			Reason:     extension.GetReason(),
+		})
+	}
+
+	if _, err := d.db.NewInsert().Model(&models).
+		On("CONFLICT (homework_id, student_id) DO UPDATE").
+		Set("due_date = EXCLUDED.due_date").
+		Set("reason = EXCLUDED.reason").
+		Set("granted_at = current_timestamp").
+		Returning("*").Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to grant extensions: %w", err)
+	}
+
+	klog.Info("Extensions granted successfully.")
+
+	return extensionsToProto(models), nil
+}
+
+// RevokeExtensions removes the extensions of the given students on a homework.
+func (d *Database) RevokeExtensions(ctx context.Context, homeworkID string, studentIDs []string) error {
+	if _, err := d.db.NewDelete().Model((*Extension)(nil)).Where("homework_id = ?", homeworkID).
+		Where("student_id IN (?)", bun.In(studentIDs)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to revoke extensions: %w", err)
+	}
+
+	klog.Info("Extensions revoked successfully.")
+
+	return nil
+}
+
+// ListExtensions retrieves all extensions granted on a homework.
+func (d *Database) ListExtensions(ctx context.Context, homeworkID string) ([]*hpb.Extension, error) {
+	var extensions []Extension
+
+	if err := d.db.NewSelect().Model(&extensions).Where("homework_id = ?", homeworkID).
+		Order("student_id").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list extensions: %w", err)
+	}
+
+	return extensionsToProto(extensions), nil
+}
+
+// GetExtension retrieves a student's extension on a homework.
+func (d *Database) GetExtension(ctx context.Context, homeworkID, studentID string) (*hpb.Extension, error) {
+	extension := new(Extension)
+
+	if err := d.db.NewSelect().Model(extension).Where("homework_id = ?", homeworkID).
+		Where("student_id = ?", studentID).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get extension: %w", err)
+	}
+
+	return extension.toProto(), nil
+}
+
+// extensionsToProto converts a list of database extensions into messages.
+func extensionsToProto(extensions []Extension) []*hpb.Extension {
+	result := make([]*hpb.Extension, 0, len(extensions))
+
+	for i := range extensions {
+		result = append(result, extensions[i].toProto())
+	}
+
+	return result
+}
+
+// Grade is the score and feedback given to a submission.
+type Grade struct {
+	ID           string               `bun:"id,pk,default:gen_random_uuid()"`
+	SubmissionID string               `bun:"submission_id,notnull,unique"`
+	Score        float64              `bun:"score,notnull"`
+	MaxScore     float64              `bun:"max_score,notnull"`
+	Feedback     string               `bun:"feedback,notnull"`
+	Selections   []CriterionSelection `bun:"selections,type:jsonb"`
+	GradedAt     time.Time            `bun:"graded_at,notnull,default:current_timestamp"`
+	UpdatedAt    time.Time            `bun:"updated_at,notnull,default:current_timestamp"`
+
+	Submission *Submission `bun:"rel:belongs-to,join:submission_id=id,on_delete:CASCADE"`
+}
+
+// CriterionSelection is the persisted form of a rubric selection. The points are copied
+// from the rubric so later rubric changes leave existing grades intact.
+type CriterionSelection struct {
+	CriterionID string  `json:"criterionId"`
+	LevelID     string  `json:"levelId"`
+	Comment     string  `json:"comment"`
+	Points      float64 `json:"points"`
+}
+
+// toProto converts the database model, with its submission loaded, into a grade message.
+func (g *Grade) toProto() *hpb.Grade {
+	selections := make([]*hpb.CriterionSelection, 0, len(g.Selections))
+
+	for _, selection := range g.Selections {
+		selections = append(selections, &hpb.CriterionSelection{
+			CriterionId: selection.CriterionID,
+			LevelId:     selection.LevelID,
+			Comment:     selection.Comment,
+			Points:      selection.Points,
+		})
+	}
+
+	return &hpb.Grade{
+		SubmissionId:   g.SubmissionID,
+		HomeworkId:     g.Submission.HomeworkID,
+		StudentId:      g.Submission.StudentID,
+		GroupId:        g.Submission.GroupID,
+		Score:          g.Score,
+		MaxScore:       g.MaxScore,
+		Feedback:       g.Feedback,
+		PenaltyPercent: g.Submission.PenaltyPercent,
+		FinalScore:     g.Score * (maxPenaltyPercent - g.Submission.PenaltyPercent) / maxPenaltyPercent,
+		GradedAt:       toTimestamp(g.GradedAt),
+		UpdatedAt:      toTimestamp(g.UpdatedAt),
+		Selections:     selections,
+	}
+}
+
+// SetGrade inserts the grade of a submission, replacing its previous grade.
+func (d *Database) SetGrade(ctx context.Context, grade *hpb.Grade) (*hpb.Grade, error) {
+	model := &Grade{
+		SubmissionID: grade.GetSubmissionId(),
+		Score:        grade.GetScore(),
+		MaxScore:     grade.GetMaxScore(),
+		Feedback:     grade.GetFeedback(),
+		Submission:   new(Submission),
+	}
+
+	for _, selection := range grade.GetSelections() {
+		model.Selections = append(model.Selections, CriterionSelection{
+			CriterionID: selection.GetCriterionId(),
+			LevelID:     selection.GetLevelId(),
+			Comment:     selection.GetComment(),
+			Points:      selection.GetPoints(),
+		})
+	}
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if err := tx.NewSelect().Model(model.Submission).Where("id = ?", model.SubmissionID).
+			Scan(ctx); err != nil {
+			return fmt.Errorf("failed to get submission: %w", err)
+		}
+
+		if _, err := tx.NewInsert().Model(model).
+			On("CONFLICT (submission_id) DO UPDATE").
+			Set("score = EXCLUDED.score").
+			Set("max_score = EXCLUDED.max_score").
+			Set("feedback = EXCLUDED.feedback").
+			Set("selections = EXCLUDED.selections").
+			Set("updated_at = current_timestamp").
+			Returning("*").Exec(ctx); err != nil {
+			return fmt.Errorf("failed to set grade: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	klog.Info("Grade set successfully.")
+
+	return model.toProto(), nil
+}
+
+// GetGrades retrieves the grades of the final submissions for the homework with the given ID.
+func (d *Database) GetGrades(ctx context.Context, homeworkID string) ([]*hpb.Grade, error) {
+	var grades []Grade
+
+	if err := d.db.NewSelect().Model(&grades).Relation("Submission").
+		Where("submission.homework_id = ?", homeworkID).Where("submission.final").
+		Order("submission.submission_time").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get grades: %w", err)
+	}
+
+	return gradesToProto(grades), nil
+}
+
+// GetStudentGrades retrieves the grades of the given student's final submissions across all
+// homeworks, including those made by other members of the student's groups. A non-nil courseIDs
+// limits the result to homeworks of those courses.
+func (d *Database) GetStudentGrades(ctx context.Context, studentID string,
+	courseIDs []string,
+) ([]*hpb.Grade, error) {
+	var grades []Grade
+
+	query := d.db.NewSelect().Model(&grades).Relation("Submission").
+		Where("submission.student_id = ? OR submission.group_id IN (?)", studentID, d.studentGroups(studentID)).
+		Where("submission.final")
+	if courseIDs != nil {
+		query = query.Where("submission.homework_id IN (?)", d.courseHomeworks(courseIDs))
+	}
+
+	if err := query.Order("submission.homework_id").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get grades: %w", err)
+	}
+
+	return gradesToProto(grades), nil
+}
+
+// gradesToProto converts a list of database grades, with their submissions loaded, into messages.
+func gradesToProto(grades []Grade) []*hpb.Grade {
+	result := make([]*hpb.Grade, 0, len(grades))
+
+	for i := range grades {
+		result = append(result, grades[i].toProto())
+	}
+
+	return result
+}
+
+// Regrade is a student's request to reconsider the grade of a submission.
+type Regrade struct {
+	ID            string    `bun:"id,pk,default:gen_random_uuid()"`
+	SubmissionID  string    `bun:"submission_id,notnull"`
+	HomeworkID    string    `bun:"homework_id,notnull"`
+	StudentID     string    `bun:"student_id,notnull"`
+	Justification string    `bun:"justification,notnull"`
+	Status        string    `bun:"status,notnull"`
+	Response      string    `bun:"response,notnull,default:''"`
+	OriginalScore float64   `bun:"original_score,notnull"`
+	MaxScore      float64   `bun:"max_score,notnull"`
+	AdjustedScore *float64  `bun:"adjusted_score"`
+	CreatedAt     time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	ResolvedAt    time.Time `bun:"resolved_at,nullzero"`
+
+	Submission *Submission `bun:"rel:belongs-to,join:submission_id=id,on_delete:CASCADE"`
+}
+
+// toProto converts the database model into a regrade message that shares no memory with it.
+func (r *Regrade) toProto() *hpb.Regrade {
+	var adjustedScore *float64
+	if r.AdjustedScore != nil {
+		score := *r.AdjustedScore
+		adjustedScore = &score
+	}
+
+	return &hpb.Regrade{
+		Id:            r.ID,
+		SubmissionId:  r.SubmissionID,
+		HomeworkId:    r.HomeworkID,
+		StudentId:     r.StudentID,
+		Justification: r.Justification,
+		Status:        hpb.RegradeStatus(hpb.RegradeStatus_value[r.Status]),
+		Response:      r.Response,
+		OriginalScore: r.OriginalScore,
+		MaxScore:      r.MaxScore,
+		AdjustedScore: adjustedScore,
+		CreatedAt:     toTimestamp(r.CreatedAt),
+		ResolvedAt:    toTimestamp(r.ResolvedAt),
+	}
+}
+
+// AddRegrade opens a regrade request on a graded submission, recording the grade's current score.
+func (d *Database) AddRegrade(ctx context.Context, regrade *hpb.Regrade) (*hpb.Regrade, error) {
+	model := &Regrade{
+		SubmissionID:  regrade.GetSubmissionId(),
+		StudentID:     regrade.GetStudentId(),
+		Justification: regrade.GetJustification(),
+		Status:        hpb.RegradeStatus_REGRADE_STATUS_OPEN.String(),
+	}
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		grade := new(Grade)
+
+		// lock the grade so concurrent requests on the same submission are serialized.
+		err := tx.NewSelect().Model(grade).Relation("Submission").
+			Where("grade.submission_id = ?", model.SubmissionID).For("UPDATE OF grade").Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: %s", errNotGraded, model.SubmissionID)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to get grade: %w", err)
+		}
+
+		open, err := tx.NewSelect().Model((*Regrade)(nil)).Where("submission_id = ?", model.SubmissionID).
+			Where("status = ?", model.Status).Exists(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get regrade requests: %w", err)
+		}
+
+		if open {
+			return fmt.Errorf("%w: %s", errRegradeOpen, model.SubmissionID)
+		}
+
+		model.HomeworkID = grade.Submission.HomeworkID
+		model.OriginalScore = grade.Score
+		model.MaxScore = grade.MaxScore
+
+		if _, err := tx.NewInsert().Model(model).Returning("*").Exec(ctx); err != nil {
+			return fmt.Errorf("failed to insert regrade request: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	klog.Info("Regrade request added successfully.")
+
+	return model.toProto(), nil
+}
+
+// GetRegrade retrieves a regrade request by ID.
+func (d *Database) GetRegrade(ctx context.Context, id string) (*hpb.Regrade, error) {
+	regrade := new(Regrade)
+
+	if err := d.db.NewSelect().Model(regrade).Where("id = ?", id).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get regrade request: %w", err)
+	}
+
+	return regrade.toProto(), nil
+}
+
+// ResolveRegrade closes an open regrade request with the given status and response.
+// Accepting it with an adjusted score also replaces the score of the submission's grade and
+// clears its rubric selections, and the grade is returned; otherwise the returned grade is nil.
+func (d *Database) ResolveRegrade(ctx context.Context, resolution *hpb.Regrade) (*hpb.Regrade, *hpb.Grade, error) {
+	regrade := &Regrade{
+		ID:            resolution.GetId(),
+		Status:        resolution.GetStatus().String(),
+		Response:      resolution.GetResponse(),
+		AdjustedScore: resolution.AdjustedScore,
+	}
+
+	var grade *Grade
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		result, err := tx.NewUpdate().Model(regrade).
+			Set("status = ?status").Set("response = ?response").Set("adjusted_score = ?adjusted_score").
+			Set("resolved_at = current_timestamp").
+			WherePK().Where("status = ?", hpb.RegradeStatus_REGRADE_STATUS_OPEN.String()).
+			Returning("*").Exec(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: %s", errRegradeResolved, regrade.ID)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to update regrade request: %w", err)
+		}
+
+		if rows, err := result.RowsAffected(); err == nil && rows == 0 {
+			return fmt.Errorf("%w: %s", errRegradeResolved, regrade.ID)
+		}
+
+		if regrade.AdjustedScore == nil {
+			return nil
+		}
+
+		grade = &Grade{Submission: new(Submission)}
+
+		// the rubric selections no longer add up to the adjusted score.
+		if _, err := tx.NewUpdate().Model(grade).Set("score = ?", *regrade.AdjustedScore).
+			Set("selections = NULL").Set("updated_at = current_timestamp").
+			Where("submission_id = ?", regrade.SubmissionID).
+			Returning("*").Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update grade: %w", err)
+		}
+
+		if err := tx.NewSelect().Model(grade.Submission).Where("id = ?", regrade.SubmissionID).
+			Scan(ctx); err != nil {
+			return fmt.Errorf("failed to get submission: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	klog.Info("Regrade request resolved successfully.")
+
+	if grade == nil {
+		return regrade.toProto(), nil, nil
+	}
+
+	return regrade.toProto(), grade.toProto(), nil
+}
+
+// ListOpenRegrades retrieves the open regrade requests on homeworks of the given course, oldest first.
+func (d *Database) ListOpenRegrades(ctx context.Context, courseID string) ([]*hpb.Regrade, error) {
+	var regrades []Regrade
+
+	homeworks := d.db.NewSelect().Model((*Homework)(nil)).Column("id").Where("course_id = ?", courseID)
+
+	if err := d.db.NewSelect().Model(&regrades).Where("homework_id IN (?)", homeworks).
+		Where("status = ?", hpb.RegradeStatus_REGRADE_STATUS_OPEN.String()).
+		Order("created_at").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list regrade requests: %w", err)
+	}
+
+	result := make([]*hpb.Regrade, 0, len(regrades))
+	for i := range regrades {
+		result = append(result, regrades[i].toProto())
+	}
+
+	return result, nil
+}
+
+// WorkflowProgress records the workflow steps a student completed on a homework.
+type WorkflowProgress struct {
+	bun.BaseModel `bun:"table:workflow_progress"`
+
+	ID         string           `bun:"id,pk,default:gen_random_uuid()"`
+	HomeworkID string           `bun:"homework_id,notnull,unique:workflow_progress_homework_id_student_id"`
+	StudentID  string           `bun:"student_id,notnull,unique:workflow_progress_homework_id_student_id"`
+	Completed  []StepCompletion `bun:"completed,type:jsonb,notnull"`
+	UpdatedAt  time.Time        `bun:"updated_at,notnull,default:current_timestamp"`
+
+	Homework *Homework `bun:"rel:belongs-to,join:homework_id=id,on_delete:CASCADE"`
+}
+
+// StepCompletion is the persisted form of a completed workflow step.
+type StepCompletion struct {
+	Step        string    `json:"step"`
+	CompletedAt time.Time `json:"completedAt"`
+}
+
+// toProto converts the database model into a progress message, leaving the next step to the caller.
+func (p *WorkflowProgress) toProto() *hpb.WorkflowProgress {
+	completed := make([]*hpb.StepCompletion, 0, len(p.Completed))
+
+	for _, step := range p.Completed {
+		completed = append(completed, &hpb.StepCompletion{
+			Step:        step.Step,
+			CompletedAt: toTimestamp(step.CompletedAt),
+		})
+	}
+
+	return &hpb.WorkflowProgress{
+		HomeworkId:     p.HomeworkID,
+		StudentId:      p.StudentID,
+		CompletedSteps: completed,
+	}
+}
+
+// AdvanceWorkflow completes a step of a homework's workflow for a student. The step must be
+// the first of the given steps the student has not completed yet, matched by name.
+func (d *Database) AdvanceWorkflow(ctx context.Context, homeworkID, studentID, step string,
+	steps []string, completedAt time.Time,
+) (*hpb.WorkflowProgress, error) {
+	progress := &WorkflowProgress{HomeworkID: homeworkID, StudentID: studentID, Completed: []StepCompletion{}}
+
+	err := d.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		// make sure the row exists so it can be locked.
+		if _, err := tx.NewInsert().Model(progress).
+			On("CONFLICT (homework_id, student_id) DO NOTHING").Returning("NULL").Exec(ctx); err != nil {
+			return fmt.Errorf("failed to insert workflow progress: %w", err)
+		}
+
+		if err := tx.NewSelect().Model(progress).Where("homework_id = ?", homeworkID).
+			Where("student_id = ?", studentID).For("UPDATE").Scan(ctx); err != nil {
+			return fmt.Errorf("failed to get workflow progress: %w", err)
+		}
+
+		next, ok := nextWorkflowStep(steps, progress.Completed)
+		if !ok {
+			return errWorkflowDone
+		}
+
+		if next != step {
+			return fmt.Errorf("%w: the next step is %q", errStepOutOfOrder, next)
+		}
+
+		progress.Completed = append(progress.Completed, StepCompletion{Step: step, CompletedAt: completedAt})
+
+		if _, err := tx.NewUpdate().Model(progress).Set("completed = ?completed").
+			Set("updated_at = current_timestamp").WherePK().Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update workflow progress: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	klog.Info("Workflow advanced successfully.")
+
+	return progress.toProto(), nil
+}
+
+// ListWorkflowProgress retrieves the workflow progress of a student on a homework, or of every
+// student who started the workflow when studentID is empty, ordered by student.
+func (d *Database) ListWorkflowProgress(ctx context.Context,
+	homeworkID, studentID string,
+) ([]*hpb.WorkflowProgress, error) {
+	var progress []WorkflowProgress
+
+	query := d.db.NewSelect().Model(&progress).Where("homework_id = ?", homeworkID)
+	if studentID != "" {
+		query = query.Where("student_id = ?", studentID)
+	}
+
+	if err := query.Order("student_id").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to list workflow progress: %w", err)
+	}
+
+	result := make([]*hpb.WorkflowProgress, 0, len(progress))
+	for i := range progress {
+		result = append(result, progress[i].toProto())
+	}
+
+	return result, nil
+}
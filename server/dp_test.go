@@ -0,0 +1,44 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//nolint:staticcheck // the deprecated string form is filled in too.
+func TestSubmissionModelRoundTrip(t *testing.T) {
+	submission := &hpb.Submission{
+		HomeworkId:         "hw-1",
+		StudentId:          "student-1",
+		SubmissionTime:     timestamppb.New(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)),
+		SubmissionTimeText: "2026-10-17T12:00:00Z",
+		SubmissionFile:     &hpb.File{Filename: "answer.txt"},
+		PartnersId:         []string{"student-2"},
+		IsLate:             true,
+		LateBy:             durationpb.New(90 * time.Minute),
+		PenaltyPercent:     10,
+		Version:            2,
+		Final:              true,
+	}
+
+	if got := newSubmission("hw-1", submission).toProto(); !proto.Equal(got, submission) {
+		t.Fatalf("round trip = %v, want %v", got, submission)
+	}
+}
+
+func TestLatePolicyModelRoundTrip(t *testing.T) {
+	for _, policy := range []*hpb.LatePolicy{
+		nil,
+		{GracePeriod: durationpb.New(time.Hour), PenaltyPerDay: 10, MaxPenalty: 50},
+		{GracePeriod: durationpb.New(0), HardCutoff: durationpb.New(48 * time.Hour)},
+	} {
+		if got := newLatePolicy(policy).toProto(); !proto.Equal(got, policy) {
+			t.Errorf("round trip = %v, want %v", got, policy)
+		}
+	}
+}
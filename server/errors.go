@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/uptrace/bun/driver/pgdriver"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// SQLSTATE of unique constraint violations.
+	pgUniqueViolation = "23505"
+	// SQLSTATE classes of connection failures and of the server shutting down or refusing connections.
+	pgConnectionClass = "08"
+	pgOperatorClass   = "57P"
+)
+
+// statusError maps an error of the database or the blob store to a status error whose
+// message starts with the failed operation, e.g. "failed to get homework 42".
+// Errors that already carry a status are returned as is.
+func statusError(err error, format string, args ...any) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	operation := fmt.Sprintf(format, args...)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Errorf(codes.NotFound, "%s: not found", operation)
+	case errors.Is(err, errBlobNotFound):
+		return status.Errorf(codes.NotFound, "%s: %v", operation, err)
+	case errors.Is(err, errStaleVersion):
+		return status.Errorf(codes.Aborted, "%s: %v", operation, err)
+	case errors.Is(err, errRegradeOpen):
+		return status.Errorf(codes.AlreadyExists, "%s: %v", operation, err)
+	case errors.Is(err, errMaxAttemptsReached), errors.Is(err, errGroupConflict), errors.Is(err, errNotGraded),
+		errors.Is(err, errRegradeResolved), errors.Is(err, errStepOutOfOrder), errors.Is(err, errWorkflowDone):
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", operation, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Errorf(codes.DeadlineExceeded, "%s: %v", operation, err)
+	case errors.Is(err, context.Canceled):
+		return status.Errorf(codes.Canceled, "%s: %v", operation, err)
+	case isUniqueViolation(err):
+		return status.Errorf(codes.AlreadyExists, "%s: already exists", operation)
+	case errors.Is(err, errAlreadyExists):
+		return status.Errorf(codes.AlreadyExists, "%s: already exists", operation)
+	case isConnectionError(err):
+		return status.Errorf(codes.Unavailable, "%s: %v", operation, err)
+	}
+
+	return status.Errorf(codes.Internal, "%s: %v", operation, err)
+}
+
+// isUniqueViolation reports whether the error is a PostgreSQL unique constraint violation.
+func isUniqueViolation(err error) bool {
+	var pgErr pgdriver.Error
+
+	return errors.As(err, &pgErr) && pgErr.Field('C') == pgUniqueViolation
+}
+
+// isConnectionError reports whether the error stems from a broken or refused connection
+// rather than from the request itself.
+func isConnectionError(err error) bool {
+	var pgErr pgdriver.Error
+	if errors.As(err, &pgErr) {
+		code := pgErr.Field('C')
+
+		return strings.HasPrefix(code, pgConnectionClass) || strings.HasPrefix(code, pgOperatorClass)
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) || errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// invalidArgument returns an InvalidArgument status error describing the offending request
+// field, e.g. "homework.maxAttempts", in its google.rpc.BadRequest details.
+func invalidArgument(field, format string, args ...any) error {
+	description := fmt.Sprintf(format, args...)
+
+	st, err := status.New(codes.InvalidArgument, description).WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, description)
+	}
+
+	return st.Err()
+}
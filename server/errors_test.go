@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{fmt.Errorf("failed to get homework: %w", sql.ErrNoRows), codes.NotFound},
+		{errRegradeOpen, codes.AlreadyExists},
+		{fmt.Errorf("failed to add submission: %w", errMaxAttemptsReached), codes.FailedPrecondition},
+		{errGroupConflict, codes.FailedPrecondition},
+		{errNotGraded, codes.FailedPrecondition},
+		{errRegradeResolved, codes.FailedPrecondition},
+		{errStepOutOfOrder, codes.FailedPrecondition},
+		{errWorkflowDone, codes.FailedPrecondition},
+		{context.DeadlineExceeded, codes.DeadlineExceeded},
+		{context.Canceled, codes.Canceled},
+		{&net.OpError{Op: "dial", Err: errors.New("connection refused")}, codes.Unavailable},
+		{sql.ErrConnDone, codes.Unavailable},
+		{status.Error(codes.PermissionDenied, "denied"), codes.PermissionDenied},
+		{errors.New("disk on fire"), codes.Internal},
+	}
+
+	for _, tt := range tests {
+		err := statusError(tt.err, "failed to get homework %s", "hw-1")
+		if got := status.Code(err); got != tt.want {
+			t.Errorf("statusError(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+
+		// status errors pass through untouched; the others name the failed operation.
+		message := status.Convert(err).Message()
+		if tt.want != codes.PermissionDenied && !strings.HasPrefix(message, "failed to get homework hw-1") {
+			t.Errorf("statusError(%v) message = %q, want the operation first", tt.err, message)
+		}
+	}
+}
+
+func TestInvalidArgumentFieldViolation(t *testing.T) {
+	err := invalidArgument("homework.maxAttempts", "must not be %s", "negative")
+	wantCode(t, err, codes.InvalidArgument)
+
+	for _, detail := range status.Convert(err).Details() {
+		if request, ok := detail.(*errdetails.BadRequest); ok {
+			violations := request.GetFieldViolations()
+			if len(violations) != 1 || violations[0].GetField() != "homework.maxAttempts" ||
+				violations[0].GetDescription() != "must not be negative" {
+				t.Fatalf("field violations = %v", violations)
+			}
+
+			return
+		}
+	}
+
+	t.Fatal("no BadRequest details")
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"k8s.io/klog/v2"
+)
+
+// effectiveDueDate returns the due date that applies to a submission made for the given
+// students: the latest of their extensions, or the homework's due date for students
+// without one. It is the zero time for homeworks without a due date, which are never
+// late whatever extensions were granted.
+func (s *HomeworkServer) effectiveDueDate(ctx context.Context, homework *hpb.Homework,
+	studentIDs ...string,
+) (time.Time, error) {
+	if homework.GetDueDate() == nil {
+		return time.Time{}, nil
+	}
+
+	var latest time.Time
+
+	for _, studentID := range studentIDs {
+		due := fromTimestamp(homework.GetDueDate())
+
+		extension, err := s.db.GetExtension(ctx, homework.GetId(), studentID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, err
+		}
+
+		if err == nil {
+			due = fromTimestamp(extension.GetDueDate())
+		}
+
+		if latest.IsZero() || due.After(latest) {
+			latest = due
+		}
+	}
+
+	return latest, nil
+}
+
+// GrantExtension grants students an individual due date for a homework.
+func (s *HomeworkServer) GrantExtension(ctx context.Context,
+	req *hpb.GrantExtensionRequest,
+) (*hpb.GrantExtensionResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GrantExtension request", "homeworkId", req.GetHomeworkId(),
+		"studentIds", req.GetStudentIds())
+
+	if len(req.GetStudentIds()) == 0 {
+		return nil, invalidArgument("studentIds", "studentIds is empty")
+	}
+
+	if req.GetDueDate() == nil {
+		return nil, invalidArgument("dueDate", "dueDate is nil")
+	}
+
+	if err := req.GetDueDate().CheckValid(); err != nil {
+		return nil, invalidArgument("dueDate", "invalid dueDate: %v", err)
+	}
+
+	if _, err := s.authorizeHomework(ctx, req.GetHomeworkId(), caller.isStaff); err != nil {
+		return nil, err
+	}
+
+	extensions := make([]*hpb.Extension, 0, len(req.GetStudentIds()))
+
+	for _, studentID := range req.GetStudentIds() {
+		if studentID == "" {
+			return nil, invalidArgument("studentIds", "studentIds contains an empty id")
+		}
+
+		extensions = append(extensions, &hpb.Extension{
+			HomeworkId: req.GetHomeworkId(),
+			StudentId:  studentID,
+			DueDate:    req.GetDueDate(),
+			Reason:     req.GetReason(),
+		})
+	}
+
+	// store the extensions in the database.
+	granted, err := s.db.GrantExtensions(ctx, extensions)
+	if err != nil {
+		logger.Error(err, "failed to grant extensions", "homeworkId", req.GetHomeworkId())
+		return nil, statusError(err, "failed to grant extensions")
+	}
+
+	logger.V(logLevelDebug).Info("Successfully granted extensions", "homeworkId", req.GetHomeworkId(),
+		"count", len(granted))
+
+	return &hpb.GrantExtensionResponse{Extensions: granted}, nil
+}
+
+// RevokeExtension revokes the extensions of students on a homework.
+func (s *HomeworkServer) RevokeExtension(ctx context.Context,
+	req *hpb.RevokeExtensionRequest,
+) (*hpb.RevokeExtensionResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received RevokeExtension request", "homeworkId", req.GetHomeworkId(),
+		"studentIds", req.GetStudentIds())
+
+	if len(req.GetStudentIds()) == 0 {
+		return nil, invalidArgument("studentIds", "studentIds is empty")
+	}
+
+	if _, err := s.authorizeHomework(ctx, req.GetHomeworkId(), caller.isStaff); err != nil {
+		return nil, err
+	}
+
+	// remove the extensions from the database.
+	if err := s.db.RevokeExtensions(ctx, req.GetHomeworkId(), req.GetStudentIds()); err != nil {
+		logger.Error(err, "failed to revoke extensions", "homeworkId", req.GetHomeworkId())
+		return nil, statusError(err, "failed to revoke extensions")
+	}
+
+	logger.V(logLevelDebug).Info("Successfully revoked extensions", "homeworkId", req.GetHomeworkId())
+
+	return &hpb.RevokeExtensionResponse{}, nil
+}
+
+// ListExtensions lists the extensions granted on a homework.
+func (s *HomeworkServer) ListExtensions(ctx context.Context,
+	req *hpb.ListExtensionsRequest,
+) (*hpb.ListExtensionsResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received ListExtensions request", "homeworkId", req.GetHomeworkId())
+
+	if _, err := s.authorizeHomework(ctx, req.GetHomeworkId(), caller.isStaff); err != nil {
+		return nil, err
+	}
+
+	// get the extensions from the database.
+	extensions, err := s.db.ListExtensions(ctx, req.GetHomeworkId())
+	if err != nil {
+		logger.Error(err, "failed to list extensions", "homeworkId", req.GetHomeworkId())
+		return nil, statusError(err, "failed to list extensions")
+	}
+
+	logger.V(logLevelDebug).Info("Successfully listed extensions", "homeworkId", req.GetHomeworkId(),
+		"count", len(extensions))
+
+	return &hpb.ListExtensionsResponse{Extensions: extensions}, nil
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestExtensionRequestValidation(t *testing.T) {
+	// every request below is rejected before the database is reached.
+	server, ctx := newValidationServer(t)
+	due := timestamppb.New(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{"grant to nobody", func() error {
+			_, err := server.GrantExtension(ctx, &hpb.GrantExtensionRequest{
+				HomeworkId: "hw-1", DueDate: due,
+			})
+			return err
+		}, codes.InvalidArgument},
+		{"grant without a due date", func() error {
+			_, err := server.GrantExtension(ctx, &hpb.GrantExtensionRequest{
+				HomeworkId: "hw-1", StudentIds: []string{"student-1"},
+			})
+			return err
+		}, codes.InvalidArgument},
+		{"grant an invalid due date", func() error {
+			_, err := server.GrantExtension(ctx, &hpb.GrantExtensionRequest{
+				HomeworkId: "hw-1", StudentIds: []string{"student-1"},
+				DueDate: &timestamppb.Timestamp{Nanos: -1},
+			})
+			return err
+		}, codes.InvalidArgument},
+		{"revoke from nobody", func() error {
+			_, err := server.RevokeExtension(ctx, &hpb.RevokeExtensionRequest{HomeworkId: "hw-1"})
+			return err
+		}, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantCode(t, tt.call(), tt.want)
+		})
+	}
+}
+
+func TestExtensions(t *testing.T) {
+	due := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	homework := newTestHomework("hw-1")
+	homework.DueDate = timestamppb.New(due)
+	homework.MaxAttempts = 0
+	homework.MaxGroupSize = 2
+
+	course := newCourseTest(t, homework)
+	client, staff, student := course.client, course.staff, course.student
+	course.server.now = func() time.Time { return due.Add(24 * time.Hour) }
+
+	extended := due.Add(48 * time.Hour)
+
+	granted, err := client.GrantExtension(staff, &hpb.GrantExtensionRequest{
+		HomeworkId: "hw-1",
+		StudentIds: []string{"student-2"},
+		DueDate:    timestamppb.New(extended),
+		Reason:     "illness",
+	})
+	if err != nil {
+		t.Fatalf("GrantExtension: %v", err)
+	}
+
+	if len(granted.GetExtensions()) != 1 || granted.GetExtensions()[0].GetGrantedAt() == nil {
+		t.Fatalf("GrantExtension returned %v", granted.GetExtensions())
+	}
+
+	submit := func(partners ...string) *hpb.Submission {
+		t.Helper()
+
+		resp, err := client.SubmitHomework(student, &hpb.SubmitHomeworkRequest{
+			Id:         "hw-1",
+			Submission: &hpb.Submission{StudentId: "student-1", PartnersId: partners},
+		})
+		if err != nil {
+			t.Fatalf("SubmitHomework: %v", err)
+		}
+
+		return resp.GetSubmission()
+	}
+
+	// a group is due at the latest due date of its members.
+	if submission := submit("student-2"); submission.GetIsLate() {
+		t.Fatalf("a group with an extended member is late by %v", submission.GetLateBy().AsDuration())
+	}
+
+	listed, err := client.ListExtensions(staff, &hpb.ListExtensionsRequest{HomeworkId: "hw-1"})
+	if err != nil {
+		t.Fatalf("ListExtensions: %v", err)
+	}
+
+	if len(listed.GetExtensions()) != 1 || listed.GetExtensions()[0].GetStudentId() != "student-2" ||
+		!listed.GetExtensions()[0].GetDueDate().AsTime().Equal(extended) {
+		t.Fatalf("ListExtensions returned %v", listed.GetExtensions())
+	}
+
+	if _, err := client.RevokeExtension(staff, &hpb.RevokeExtensionRequest{
+		HomeworkId: "hw-1",
+		StudentIds: []string{"student-2"},
+	}); err != nil {
+		t.Fatalf("RevokeExtension: %v", err)
+	}
+
+	if submission := submit(); !submission.GetIsLate() || submission.GetLateBy().AsDuration() != 24*time.Hour {
+		t.Fatalf("after the revocation the group is late by %v, want 24h", submission.GetLateBy().AsDuration())
+	}
+
+	_, err = client.GrantExtension(student, &hpb.GrantExtensionRequest{
+		HomeworkId: "hw-1",
+		StudentIds: []string{"student-1"},
+		DueDate:    timestamppb.New(extended),
+	})
+	wantCode(t, err, codes.PermissionDenied)
+
+	_, err = client.ListExtensions(student, &hpb.ListExtensionsRequest{HomeworkId: "hw-1"})
+	wantCode(t, err, codes.PermissionDenied)
+
+	_, err = client.GrantExtension(staff, &hpb.GrantExtensionRequest{
+		HomeworkId: "hw-1",
+		StudentIds: []string{""},
+		DueDate:    timestamppb.New(extended),
+	})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestExtensionWithoutDueDate(t *testing.T) {
+	homework := newTestHomework("hw-1")
+	homework.DueDate = nil
+	homework.MaxGroupSize = 2
+
+	course := newCourseTest(t, homework)
+
+	// an extension that already passed, granted to one member of the group.
+	if _, err := course.client.GrantExtension(course.staff, &hpb.GrantExtensionRequest{
+		HomeworkId: "hw-1",
+		StudentIds: []string{"student-2"},
+		DueDate:    timestamppb.New(time.Now().Add(-time.Hour)),
+	}); err != nil {
+		t.Fatalf("GrantExtension: %v", err)
+	}
+
+	resp, err := course.client.SubmitHomework(course.student, &hpb.SubmitHomeworkRequest{
+		Id:         "hw-1",
+		Submission: &hpb.Submission{StudentId: "student-1", PartnersId: []string{"student-2"}},
+	})
+	if err != nil {
+		t.Fatalf("SubmitHomework: %v", err)
+	}
+
+	if resp.GetSubmission().GetIsLate() {
+		t.Fatalf("a submission for a homework without a due date is late by %v",
+			resp.GetSubmission().GetLateBy().AsDuration())
+	}
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
+
+// uploadReader reads the content chunks of an UploadFile stream, stopping at the
+// trailing checksum message or at the end of the stream.
+type uploadReader struct {
+	stream hpb.HomeworkService_UploadFileServer
+	buf    []byte
+	// sha256 is the checksum sent by the client, set once the trailer is received.
+	sha256 string
+	done   bool
+}
+
+// Read implements io.Reader.
+func (u *uploadReader) Read(p []byte) (int, error) {
+	for len(u.buf) == 0 {
+		if u.done {
+			return 0, io.EOF
+		}
+
+		req, err := u.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			u.done = true
+			return 0, io.EOF
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		switch data := req.GetData().(type) {
+		case *hpb.UploadFileRequest_Chunk:
+			u.buf = data.Chunk
+		case *hpb.UploadFileRequest_Sha256:
+			u.sha256 = data.Sha256
+			u.done = true
+		default:
+			return 0, invalidArgument("header", "unexpected message after header")
+		}
+	}
+
+	n := copy(p, u.buf)
+	u.buf = u.buf[n:]
+
+	return n, nil
+}
+
+// putBlob writes the content read from r to the blob store under a fresh key,
+// hashing it on the way, and returns the resulting file metadata.
+func putBlob(ctx context.Context, blobs BlobStore, filename, mimeType string, r io.Reader) (*FileRef, error) {
+	hash := sha256.New()
+	counter := &countingWriter{}
+	key := newBlobKey()
+
+	if err := blobs.Put(ctx, key, io.TeeReader(r, io.MultiWriter(hash, counter)), mimeType); err != nil {
+		return nil, err
+	}
+
+	return &FileRef{
+		Filename:   filename,
+		MimeType:   mimeType,
+		ContentRef: key,
+		SHA256:     hex.EncodeToString(hash.Sum(nil)),
+		Size:       counter.n,
+	}, nil
+}
+
+// storeFile persists a file the caller attaches to the given homework and returns its metadata.
+// Files with inline content are written to the blob store; files carrying only a content
+// reference must point at a blob previously stored for the same homework, and uploaded by the
+// caller unless the caller is staff of the homework's course.
+func (s *HomeworkServer) storeFile(ctx context.Context, caller *principal, homework *hpb.Homework,
+	file *hpb.File,
+) (*hpb.File, error) {
+	logger := klog.FromContext(ctx)
+	homeworkID := homework.GetId()
+
+	if len(file.GetContent()) == 0 {
+		if file.GetContentRef() == "" {
+			return nil, invalidArgument("contentRef", "file %q has neither content nor contentRef",
+				file.GetFilename())
+		}
+
+		stored, err := s.db.GetStoredFile(ctx, file.GetContentRef())
+		if errors.Is(err, sql.ErrNoRows) || (err == nil && stored.HomeworkID != homeworkID) {
+			return nil, status.Errorf(codes.NotFound, "contentRef %q not found for homework %s",
+				file.GetContentRef(), homeworkID)
+		}
+
+		if err != nil {
+			logger.Error(err, "failed to get stored file", "contentRef", file.GetContentRef())
+			return nil, statusError(err, "failed to get stored file")
+		}
+
+		if stored.UploadedBy != caller.id && !caller.isStaff(homework.GetCourseId()) {
+			return nil, status.Errorf(codes.PermissionDenied, "contentRef %q was uploaded by someone else",
+				file.GetContentRef())
+		}
+
+		ref := stored.fileRef()
+		if file.GetFilename() != "" {
+			ref.Filename = file.GetFilename()
+		}
+
+		return ref.toProto(), nil
+	}
+
+	ref, err := putBlob(ctx, s.blobs, file.GetFilename(), file.GetMimeType(), bytes.NewReader(file.GetContent()))
+	if err != nil {
+		logger.Error(err, "failed to store file content", "filename", file.GetFilename())
+		return nil, statusError(err, "failed to store file content")
+	}
+
+	if err := s.db.AddStoredFile(ctx, homeworkID, caller.id, ref); err != nil {
+		logger.Error(err, "failed to record stored file", "contentRef", ref.ContentRef)
+		s.deleteBlobs(ctx, ref.ContentRef)
+
+		return nil, statusError(err, "failed to record stored file")
+	}
+
+	return ref.toProto(), nil
+}
+
+// storeFiles persists every file the caller attaches to the given homework and returns their metadata.
+func (s *HomeworkServer) storeFiles(ctx context.Context, caller *principal, homework *hpb.Homework,
+	files []*hpb.File,
+) ([]*hpb.File, error) {
+	stored := make([]*hpb.File, 0, len(files))
+
+	for _, file := range files {
+		ref, err := s.storeFile(ctx, caller, homework, file)
+		if err != nil {
+			return nil, err
+		}
+
+		stored = append(stored, ref)
+	}
+
+	return stored, nil
+}
+
+// droppedContentRefs returns the content references of the previous files that none of
+// the current files keep.
+func droppedContentRefs(previous, current []*hpb.File) []string {
+	kept := make(map[string]bool, len(current))
+	for _, file := range current {
+		kept[file.GetContentRef()] = true
+	}
+
+	var dropped []string
+
+	for _, file := range previous {
+		if ref := file.GetContentRef(); ref != "" && !kept[ref] {
+			dropped = append(dropped, ref)
+		}
+	}
+
+	return dropped
+}
+
+// loadContent reads the stored content of each file back into the message.
+func (s *HomeworkServer) loadContent(ctx context.Context, files ...*hpb.File) error {
+	for _, file := range files {
+		if file == nil || file.GetContentRef() == "" {
+			continue
+		}
+
+		reader, err := s.blobs.Get(ctx, file.GetContentRef(), 0)
+		if err != nil {
+			return err
+		}
+
+		content, err := io.ReadAll(reader)
+		reader.Close()
+
+		if err != nil {
+			return err
+		}
+
+		file.Content = content
+	}
+
+	return nil
+}
+
+// loadSubmissionContent reads the stored content of each submission's file back into the message.
+func (s *HomeworkServer) loadSubmissionContent(ctx context.Context, submissions []*hpb.Submission) error {
+	for _, submission := range submissions {
+		if err := s.loadContent(ctx, submission.GetSubmissionFile()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteBlobs removes the given blobs from the blob store, logging any failures.
+func (s *HomeworkServer) deleteBlobs(ctx context.Context, contentRefs ...string) {
+	for _, ref := range contentRefs {
+		if err := s.blobs.Delete(ctx, ref); err != nil {
+			klog.FromContext(ctx).Error(err, "failed to delete blob", "contentRef", ref)
+		}
+	}
+}
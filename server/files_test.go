@@ -0,0 +1,422 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// fakeUploadStream replays a fixed list of UploadFile messages.
+type fakeUploadStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*hpb.UploadFileRequest
+	response *hpb.UploadFileResponse
+}
+
+func (f *fakeUploadStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeUploadStream) Recv() (*hpb.UploadFileRequest, error) {
+	if len(f.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := f.requests[0]
+	f.requests = f.requests[1:]
+
+	return req, nil
+}
+
+func (f *fakeUploadStream) SendAndClose(response *hpb.UploadFileResponse) error {
+	f.response = response
+	return nil
+}
+
+func chunkRequest(chunk string) *hpb.UploadFileRequest {
+	return &hpb.UploadFileRequest{Data: &hpb.UploadFileRequest_Chunk{Chunk: []byte(chunk)}}
+}
+
+func checksumRequest(sha256 string) *hpb.UploadFileRequest {
+	return &hpb.UploadFileRequest{Data: &hpb.UploadFileRequest_Sha256{Sha256: sha256}}
+}
+
+func headerRequest(header *hpb.UploadFileHeader) *hpb.UploadFileRequest {
+	return &hpb.UploadFileRequest{Data: &hpb.UploadFileRequest_Header{Header: header}}
+}
+
+func TestUploadReader(t *testing.T) {
+	stream := &fakeUploadStream{requests: []*hpb.UploadFileRequest{
+		chunkRequest("hello, "), chunkRequest("world"), checksumRequest("abc"), chunkRequest("ignored"),
+	}}
+	reader := &uploadReader{stream: stream}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+
+	if string(content) != "hello, world" || reader.sha256 != "abc" {
+		t.Fatalf("read %q with checksum %q", content, reader.sha256)
+	}
+
+	// the message after the checksum is left for the caller to reject.
+	if len(stream.requests) != 1 {
+		t.Fatalf("%d messages left on the stream, want 1", len(stream.requests))
+	}
+
+	reader = &uploadReader{stream: &fakeUploadStream{requests: []*hpb.UploadFileRequest{
+		chunkRequest("data"), headerRequest(&hpb.UploadFileHeader{}),
+	}}}
+
+	_, err = io.ReadAll(reader)
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestUploadFileRequestValidation(t *testing.T) {
+	// every upload below is rejected before the database is reached.
+	server, ctx := newValidationServer(t)
+
+	tests := []struct {
+		name     string
+		requests []*hpb.UploadFileRequest
+		want     codes.Code
+	}{
+		{"no header", []*hpb.UploadFileRequest{chunkRequest("data")}, codes.InvalidArgument},
+		{"no homework", []*hpb.UploadFileRequest{
+			headerRequest(&hpb.UploadFileHeader{}),
+		}, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantCode(t, server.UploadFile(&fakeUploadStream{ctx: ctx, requests: tt.requests}), tt.want)
+		})
+	}
+}
+
+// upload streams the content to UploadFile in chunks of the given size, followed by the
+// checksum, and returns the stored file.
+func upload(ctx context.Context, client hpb.HomeworkServiceClient, header *hpb.UploadFileHeader,
+	content []byte, chunkSize int, checksum string,
+) (*hpb.File, error) {
+	stream, err := client.UploadFile(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := []*hpb.UploadFileRequest{headerRequest(header)}
+
+	for chunk := range slices.Chunk(content, chunkSize) {
+		messages = append(messages, &hpb.UploadFileRequest{Data: &hpb.UploadFileRequest_Chunk{Chunk: chunk}})
+	}
+
+	if checksum != "" {
+		messages = append(messages, checksumRequest(checksum))
+	}
+
+	for _, msg := range messages {
+		// the server may reject the upload before it reads every message.
+		if err := stream.Send(msg); err != nil {
+			break
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetFile(), nil
+}
+
+// checksumOf returns the hex-encoded SHA-256 digest of the content.
+func checksumOf(content []byte) string {
+	digest := sha256.Sum256(content)
+
+	return hex.EncodeToString(digest[:])
+}
+
+// download reads a whole DownloadFile stream and returns the file metadata of the first
+// message and the content from the requested offset.
+func download(ctx context.Context, client hpb.HomeworkServiceClient, req *hpb.DownloadFileRequest,
+) (*hpb.File, []byte, error) {
+	stream, err := client.DownloadFile(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		file    *hpb.File
+		content []byte
+	)
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return file, content, nil
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if resp.GetFile() != nil {
+			file = resp.GetFile()
+		}
+
+		content = append(content, resp.GetChunk()...)
+	}
+}
+
+func TestUploadAndDownloadFile(t *testing.T) {
+	course := newCourseTest(t, newTestHomework("hw-1"))
+	client, staff, student := course.client, course.staff, course.student
+
+	// large enough to be downloaded in several chunks.
+	content := bytes.Repeat([]byte("0123456789"), downloadChunkSize/4)
+	header := &hpb.UploadFileHeader{HomeworkId: "hw-1", Filename: "solution.txt", MimeType: "text/plain"}
+
+	file, err := upload(student, client, header, content, 1000, checksumOf(content))
+	if err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+
+	if file.GetSize() != int64(len(content)) || file.GetSha256() != checksumOf(content) || file.GetContentRef() == "" {
+		t.Fatalf("UploadFile returned %v", file)
+	}
+
+	submitted, err := client.SubmitHomework(student, &hpb.SubmitHomeworkRequest{
+		Id: "hw-1",
+		Submission: &hpb.Submission{
+			StudentId:      "student-1",
+			SubmissionFile: &hpb.File{ContentRef: file.GetContentRef()},
+		},
+	})
+	if err != nil {
+		t.Fatalf("SubmitHomework: %v", err)
+	}
+
+	if got := submitted.GetSubmission().GetSubmissionFile(); got.GetFilename() != "solution.txt" ||
+		got.GetSize() != file.GetSize() {
+		t.Fatalf("SubmitHomework attached %v, want the uploaded file", got)
+	}
+
+	for _, offset := range []int64{0, 1, downloadChunkSize + 7} {
+		got, downloaded, err := download(student, client, &hpb.DownloadFileRequest{
+			HomeworkId: "hw-1",
+			ContentRef: file.GetContentRef(),
+			Offset:     offset,
+		})
+		if err != nil {
+			t.Fatalf("DownloadFile from %d: %v", offset, err)
+		}
+
+		if got.GetSha256() != file.GetSha256() || !bytes.Equal(downloaded, content[offset:]) {
+			t.Fatalf("DownloadFile from %d returned %d bytes of %v, want %d", offset, len(downloaded), got,
+				len(content)-int(offset))
+		}
+	}
+
+	_, _, err = download(student, client, &hpb.DownloadFileRequest{
+		HomeworkId: "hw-1",
+		ContentRef: file.GetContentRef(),
+		Offset:     -1,
+	})
+	wantCode(t, err, codes.OutOfRange)
+
+	// a file belongs to the homework it was uploaded for.
+	if _, err := client.CreateHomework(staff, &hpb.CreateHomeworkRequest{Homework: newTestHomework("hw-2")}); err != nil {
+		t.Fatalf("CreateHomework: %v", err)
+	}
+
+	_, _, err = download(staff, client, &hpb.DownloadFileRequest{HomeworkId: "hw-2", ContentRef: file.GetContentRef()})
+	wantCode(t, err, codes.NotFound)
+}
+
+func TestUploadFileErrors(t *testing.T) {
+	course := newCourseTest(t, newTestHomework("hw-1"))
+	outsider := withToken(testToken(t, "student-2", roleStudent+courseRoleSeparator+testOtherCourse))
+
+	content := []byte("solution")
+	header := &hpb.UploadFileHeader{HomeworkId: "hw-1", Filename: "solution.txt"}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		header   *hpb.UploadFileHeader
+		checksum string
+		want     codes.Code
+	}{
+		{"checksum mismatch", course.staff, header, checksumOf([]byte("other")), codes.DataLoss},
+		{"no checksum", course.staff, header, "", codes.InvalidArgument},
+		{"unknown homework", course.staff, &hpb.UploadFileHeader{HomeworkId: "unknown"}, checksumOf(content),
+			codes.NotFound},
+		{"another course", outsider, header, checksumOf(content), codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := upload(tt.ctx, course.client, tt.header, content, 3, tt.checksum)
+			wantCode(t, err, tt.want)
+		})
+	}
+
+	// the checksum is compared ignoring case.
+	if _, err := upload(course.staff, course.client, header, content, 3,
+		strings.ToUpper(checksumOf(content))); err != nil {
+		t.Fatalf("UploadFile with an upper-case checksum: %v", err)
+	}
+}
+
+func TestDownloadFileAtEnd(t *testing.T) {
+	course := newCourseTest(t)
+	client, staff := course.client, course.staff
+
+	created, err := client.CreateHomework(staff, &hpb.CreateHomeworkRequest{Homework: newTestHomework("hw-1")})
+	if err != nil {
+		t.Fatalf("CreateHomework: %v", err)
+	}
+
+	spec := created.GetHw().GetFiles()[0]
+	if spec.GetSize() != int64(len("spec")) {
+		t.Fatalf("CreateHomework stored a file of %d bytes, want %d", spec.GetSize(), len("spec"))
+	}
+
+	// resuming at the end of the file sends its metadata and no content.
+	file, content, err := download(staff, client, &hpb.DownloadFileRequest{
+		HomeworkId: "hw-1",
+		ContentRef: spec.GetContentRef(),
+		Offset:     spec.GetSize(),
+	})
+	if err != nil {
+		t.Fatalf("DownloadFile at the end: %v", err)
+	}
+
+	if file.GetContentRef() != spec.GetContentRef() || len(content) != 0 {
+		t.Fatalf("DownloadFile at the end returned %v with %q", file, content)
+	}
+
+	_, _, err = download(staff, client, &hpb.DownloadFileRequest{
+		HomeworkId: "hw-1",
+		ContentRef: spec.GetContentRef(),
+		Offset:     spec.GetSize() + 1,
+	})
+	wantCode(t, err, codes.OutOfRange)
+
+	// a blob lost from the store is reported as missing.
+	if err := course.server.blobs.Delete(context.Background(), spec.GetContentRef()); err != nil {
+		t.Fatalf("failed to delete blob: %v", err)
+	}
+
+	_, _, err = download(staff, client, &hpb.DownloadFileRequest{HomeworkId: "hw-1", ContentRef: spec.GetContentRef()})
+	wantCode(t, err, codes.NotFound)
+}
+
+func TestUpdateHomeworkDeletesReplacedFiles(t *testing.T) {
+	course := newCourseTest(t)
+	client, staff := course.client, course.staff
+
+	created, err := client.CreateHomework(staff, &hpb.CreateHomeworkRequest{Homework: newTestHomework("hw-1")})
+	if err != nil {
+		t.Fatalf("CreateHomework: %v", err)
+	}
+
+	spec := created.GetHw().GetFiles()[0]
+
+	homework := created.GetHw()
+	homework.Files = []*hpb.File{{Filename: "spec.txt", MimeType: "text/plain", Content: []byte("new spec")}}
+
+	updated, err := client.UpdateHomework(staff, &hpb.UpdateHomeworkRequest{Homework: homework})
+	if err != nil {
+		t.Fatalf("UpdateHomework: %v", err)
+	}
+
+	// the replaced file is gone, the new one downloads.
+	_, _, err = download(staff, client, &hpb.DownloadFileRequest{HomeworkId: "hw-1", ContentRef: spec.GetContentRef()})
+	wantCode(t, err, codes.NotFound)
+
+	if reader, err := course.server.blobs.Get(context.Background(), spec.GetContentRef(), 0); err == nil {
+		reader.Close()
+		t.Fatal("the blob of the replaced file is still stored")
+	}
+
+	replacement := updated.GetHw().GetFiles()[0]
+
+	_, content, err := download(staff, client, &hpb.DownloadFileRequest{
+		HomeworkId: "hw-1",
+		ContentRef: replacement.GetContentRef(),
+	})
+	if err != nil || string(content) != "new spec" {
+		t.Fatalf("DownloadFile of the new file returned %q, %v", content, err)
+	}
+}
+
+func TestSubmitAnotherStudentsUpload(t *testing.T) {
+	course := newCourseTest(t, newTestHomework("hw-1"))
+	client, student := course.client, course.student
+	other := withToken(testToken(t, "student-2", roleStudent+courseRoleSeparator+testCourse))
+
+	content := []byte("solution")
+	header := &hpb.UploadFileHeader{HomeworkId: "hw-1", Filename: "solution.txt"}
+
+	file, err := upload(student, client, header, content, 3, checksumOf(content))
+	if err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+
+	// a student cannot submit the upload of another student as their own.
+	_, err = client.SubmitHomework(other, &hpb.SubmitHomeworkRequest{
+		Id: "hw-1",
+		Submission: &hpb.Submission{
+			StudentId:      "student-2",
+			SubmissionFile: &hpb.File{ContentRef: file.GetContentRef()},
+		},
+	})
+	wantCode(t, err, codes.PermissionDenied)
+
+	if _, err := client.SubmitHomework(student, &hpb.SubmitHomeworkRequest{
+		Id: "hw-1",
+		Submission: &hpb.Submission{
+			StudentId:      "student-1",
+			SubmissionFile: &hpb.File{ContentRef: file.GetContentRef()},
+		},
+	}); err != nil {
+		t.Fatalf("SubmitHomework by the uploader: %v", err)
+	}
+}
+
+func TestDownloadOwnUpload(t *testing.T) {
+	course := newCourseTest(t, newTestHomework("hw-1"))
+	client, student := course.client, course.student
+	other := withToken(testToken(t, "student-2", roleStudent+courseRoleSeparator+testCourse))
+
+	content := []byte("draft")
+	header := &hpb.UploadFileHeader{HomeworkId: "hw-1", Filename: "draft.txt"}
+
+	file, err := upload(student, client, header, content, 3, checksumOf(content))
+	if err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+
+	// the uploader may read the file back before attaching it, other students may not.
+	req := &hpb.DownloadFileRequest{HomeworkId: "hw-1", ContentRef: file.GetContentRef()}
+
+	if _, downloaded, err := download(student, client, req); err != nil || !bytes.Equal(downloaded, content) {
+		t.Fatalf("DownloadFile by the uploader returned %q, %v", downloaded, err)
+	}
+
+	_, _, err = download(other, client, req)
+	wantCode(t, err, codes.PermissionDenied)
+}
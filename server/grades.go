@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// SetGrade sets the score and feedback of a submission, replacing any previous grade.
+func (s *HomeworkServer) SetGrade(ctx context.Context,
+	req *hpb.SetGradeRequest,
+) (*hpb.SetGradeResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received SetGrade request", "submissionId", req.GetSubmissionId())
+
+	if req.GetSubmissionId() == "" {
+		return nil, invalidArgument("submissionId", "submissionId is empty")
+	}
+
+	_, homework, err := s.authorizeSubmission(ctx, req.GetSubmissionId(),
+		func(courseID string, _ *hpb.Submission) bool { return caller.isStaff(courseID) })
+	if err != nil {
+		return nil, err
+	}
+
+	grade := &hpb.Grade{
+		SubmissionId: req.GetSubmissionId(),
+		Score:        req.GetScore(),
+		MaxScore:     req.GetMaxScore(),
+		Feedback:     req.GetFeedback(),
+	}
+
+	if homework.GetRubric() != nil {
+		if req.GetScore() != 0 || req.GetMaxScore() != 0 {
+			return nil, invalidArgument("score",
+				"homework %s is graded by rubric; send selections instead of a score", homework.GetId())
+		}
+
+		// the score is computed from the rubric so it is consistent across graders.
+		scored, err := scoreRubric(homework.GetRubric(), req.GetSelections())
+		if err != nil {
+			return nil, invalidArgument("selections", "invalid selections: %v", err)
+		}
+
+		grade.Score, grade.MaxScore, grade.Selections = scored.score, scored.maxScore, scored.selections
+	} else {
+		if len(req.GetSelections()) > 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "homework %s has no rubric", homework.GetId())
+		}
+
+		if req.GetMaxScore() <= 0 {
+			return nil, invalidArgument("maxScore", "maxScore must be positive")
+		}
+
+		if req.GetScore() < 0 || req.GetScore() > req.GetMaxScore() {
+			return nil, invalidArgument("score", "score must be between 0 and maxScore")
+		}
+	}
+
+	grade, err = s.db.SetGrade(ctx, grade)
+	if err != nil {
+		logger.Error(err, "failed to set grade", "submissionId", req.GetSubmissionId())
+
+		return nil, statusError(err, "failed to grade submission %s", req.GetSubmissionId())
+	}
+
+	logger.V(logLevelDebug).Info("Successfully set grade", "submissionId", req.GetSubmissionId(),
+		"score", grade.GetScore())
+
+	return &hpb.SetGradeResponse{Grade: grade}, nil
+}
+
+// GetGrades retrieves the grades of the final submissions for a homework.
+func (s *HomeworkServer) GetGrades(ctx context.Context,
+	req *hpb.GetGradesRequest,
+) (*hpb.GetGradesResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetGrades request", "homeworkId", req.GetHomeworkId())
+
+	if _, err := s.authorizeHomework(ctx, req.GetHomeworkId(), caller.isStaff); err != nil {
+		return nil, err
+	}
+
+	// get the grades from the database.
+	grades, err := s.db.GetGrades(ctx, req.GetHomeworkId())
+	if err != nil {
+		logger.Error(err, "failed to get grades", "homeworkId", req.GetHomeworkId())
+		return nil, statusError(err, "failed to get grades")
+	}
+
+	logger.V(logLevelDebug).Info("Successfully fetched grades", "homeworkId", req.GetHomeworkId(),
+		"count", len(grades))
+
+	return &hpb.GetGradesResponse{Grades: grades}, nil
+}
+
+// GetStudentGrades retrieves the grades of a student's final submissions.
+func (s *HomeworkServer) GetStudentGrades(ctx context.Context,
+	req *hpb.GetStudentGradesRequest,
+) (*hpb.GetStudentGradesResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetStudentGrades request", "studentId", req.GetStudentId())
+
+	if req.GetStudentId() == "" {
+		return nil, invalidArgument("studentId", "studentId is empty")
+	}
+
+	courses, err := studentRecordCourses(caller, req.GetStudentId())
+	if err != nil {
+		return nil, err
+	}
+
+	// get the student's grades from the database.
+	grades, err := s.db.GetStudentGrades(ctx, req.GetStudentId(), courses)
+	if err != nil {
+		logger.Error(err, "failed to get student grades", "studentId", req.GetStudentId())
+		return nil, statusError(err, "failed to get student grades")
+	}
+
+	logger.V(logLevelDebug).Info("Successfully fetched student grades", "studentId", req.GetStudentId(),
+		"count", len(grades))
+
+	return &hpb.GetStudentGradesResponse{Grades: grades}, nil
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGradeFinalScore(t *testing.T) {
+	grade := &Grade{
+		SubmissionID: "submission-1",
+		Score:        80,
+		MaxScore:     100,
+		Submission:   &Submission{HomeworkID: "hw-1", StudentID: "student-1", PenaltyPercent: 25},
+	}
+
+	got := grade.toProto()
+	if got.GetFinalScore() != 60 || got.GetPenaltyPercent() != 25 || got.GetStudentId() != "student-1" {
+		t.Fatalf("toProto = %v, want a final score of 60 after a 25%% penalty", got)
+	}
+}
+
+func TestSetGradeRequestValidation(t *testing.T) {
+	// every request below is rejected before the database is reached.
+	server, ctx := newValidationServer(t)
+
+	_, err := server.SetGrade(ctx, &hpb.SetGradeRequest{Score: 1, MaxScore: 1})
+	wantCode(t, err, codes.InvalidArgument)
+
+	_, err = server.GetStudentGrades(ctx, &hpb.GetStudentGradesRequest{})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestGrades(t *testing.T) {
+	due := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	homework := newTestHomework("hw-1")
+	homework.DueDate = timestamppb.New(due)
+	homework.LatePolicy = &hpb.LatePolicy{PenaltyPerDay: 20}
+
+	course := newCourseTest(t, homework)
+	client, staff, student := course.client, course.staff, course.student
+	course.server.now = func() time.Time { return due.Add(time.Hour) }
+
+	submitted, err := client.SubmitHomework(student, &hpb.SubmitHomeworkRequest{
+		Id:         "hw-1",
+		Submission: &hpb.Submission{StudentId: "student-1"},
+	})
+	if err != nil {
+		t.Fatalf("SubmitHomework: %v", err)
+	}
+
+	submissionID := submitted.GetSubmission().GetId()
+
+	first, err := client.SetGrade(staff, &hpb.SetGradeRequest{SubmissionId: submissionID, Score: 6, MaxScore: 10})
+	if err != nil {
+		t.Fatalf("SetGrade: %v", err)
+	}
+
+	// grading again replaces the grade but keeps when it was first given.
+	graded, err := client.SetGrade(staff, &hpb.SetGradeRequest{
+		SubmissionId: submissionID,
+		Score:        8,
+		MaxScore:     10,
+		Feedback:     "Well done.",
+	})
+	if err != nil {
+		t.Fatalf("SetGrade: %v", err)
+	}
+
+	grade := graded.GetGrade()
+	if grade.GetHomeworkId() != "hw-1" || grade.GetStudentId() != "student-1" || grade.GetPenaltyPercent() != 20 ||
+		grade.GetFinalScore() != 6.4 || grade.GetFeedback() != "Well done." {
+		t.Fatalf("SetGrade returned %v, want 8 of 10 with a 20%% penalty", grade)
+	}
+
+	if !grade.GetGradedAt().AsTime().Equal(first.GetGrade().GetGradedAt().AsTime()) {
+		t.Fatalf("SetGrade moved the grading time from %v to %v", first.GetGrade().GetGradedAt().AsTime(),
+			grade.GetGradedAt().AsTime())
+	}
+
+	grades, err := client.GetGrades(staff, &hpb.GetGradesRequest{HomeworkId: "hw-1"})
+	if err != nil {
+		t.Fatalf("GetGrades: %v", err)
+	}
+
+	if len(grades.GetGrades()) != 1 || grades.GetGrades()[0].GetScore() != 8 {
+		t.Fatalf("GetGrades returned %v", grades.GetGrades())
+	}
+
+	own, err := client.GetStudentGrades(student, &hpb.GetStudentGradesRequest{StudentId: "student-1"})
+	if err != nil {
+		t.Fatalf("GetStudentGrades: %v", err)
+	}
+
+	if len(own.GetGrades()) != 1 || own.GetGrades()[0].GetFinalScore() != 6.4 {
+		t.Fatalf("GetStudentGrades returned %v", own.GetGrades())
+	}
+
+	tests := []struct {
+		name    string
+		req     *hpb.SetGradeRequest
+		byStaff bool
+		want    codes.Code
+	}{
+		{"unknown submission", &hpb.SetGradeRequest{SubmissionId: "unknown", Score: 1, MaxScore: 10}, true,
+			codes.NotFound},
+		{"no maximum", &hpb.SetGradeRequest{SubmissionId: submissionID, Score: 1}, true, codes.InvalidArgument},
+		{"above the maximum", &hpb.SetGradeRequest{SubmissionId: submissionID, Score: 11, MaxScore: 10}, true,
+			codes.InvalidArgument},
+		{"negative", &hpb.SetGradeRequest{SubmissionId: submissionID, Score: -1, MaxScore: 10}, true,
+			codes.InvalidArgument},
+		{"selections without a rubric", &hpb.SetGradeRequest{
+			SubmissionId: submissionID,
+			Selections:   []*hpb.CriterionSelection{{CriterionId: "correctness", LevelId: "full"}},
+		}, true, codes.FailedPrecondition},
+		{"by a student", &hpb.SetGradeRequest{SubmissionId: submissionID, Score: 10, MaxScore: 10}, false,
+			codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := student
+			if tt.byStaff {
+				ctx = staff
+			}
+
+			_, err := client.SetGrade(ctx, tt.req)
+			wantCode(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+)
+
+// validatePartners checks the partners a student declares on a submission: each partner
+// must be named once, must not be the submitter, and the group must fit the homework.
+func validatePartners(studentID string, partners []string, maxGroupSize int32) error {
+	seen := make(map[string]bool, len(partners))
+
+	for _, partner := range partners {
+		switch {
+		case partner == "":
+			return errors.New("partner IDs must not be empty")
+		case partner == studentID:
+			return errors.New("a student cannot be their own partner")
+		case seen[partner]:
+			return fmt.Errorf("partner %s is listed more than once", partner)
+		}
+
+		seen[partner] = true
+	}
+
+	if maxGroupSize > 0 && len(partners)+1 > int(maxGroupSize) {
+		return fmt.Errorf("groups are limited to %d students", maxGroupSize)
+	}
+
+	return nil
+}
+
+// submissionMembers returns the students a submission is made for: the submitter and
+// their declared partners, or the submitter's existing group when none are declared.
+func (s *HomeworkServer) submissionMembers(ctx context.Context, homeworkID string,
+	submission *hpb.Submission,
+) ([]string, error) {
+	if len(submission.GetPartnersId()) > 0 {
+		return append([]string{submission.GetStudentId()}, submission.GetPartnersId()...), nil
+	}
+
+	members, err := s.db.GroupMembers(ctx, homeworkID, submission.GetStudentId())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(members) == 0 {
+		return []string{submission.GetStudentId()}, nil
+	}
+
+	return members, nil
+}
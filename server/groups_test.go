@@ -0,0 +1,125 @@
+package main
+
+import (
+	"testing"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc/codes"
+)
+
+func TestValidatePartners(t *testing.T) {
+	tests := []struct {
+		name         string
+		partners     []string
+		maxGroupSize int32
+		valid        bool
+	}{
+		{"alone", nil, 1, true},
+		{"full group", []string{"student-2", "student-3"}, 3, true},
+		{"unlimited group", []string{"student-2", "student-3", "student-4"}, 0, true},
+		{"too large", []string{"student-2", "student-3"}, 2, false},
+		{"empty partner", []string{""}, 0, false},
+		{"self", []string{"student-1"}, 0, false},
+		{"duplicate", []string{"student-2", "student-2"}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validatePartners("student-1", tt.partners, tt.maxGroupSize); (err == nil) != tt.valid {
+				t.Fatalf("validatePartners = %v, want valid %v", err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestCreateHomeworkNegativeGroupSize(t *testing.T) {
+	server, ctx := newValidationServer(t)
+
+	_, err := server.CreateHomework(ctx, &hpb.CreateHomeworkRequest{
+		Homework: &hpb.Homework{Id: "hw-1", MaxGroupSize: -1},
+	})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestGroupSubmissions(t *testing.T) {
+	homework := newTestHomework("hw-1")
+	homework.MaxAttempts = 3
+	homework.MaxGroupSize = 2
+
+	course := newCourseTest(t, homework)
+
+	submit := func(studentID string, partners ...string) (*hpb.Submission, error) {
+		resp, err := course.client.SubmitHomework(course.asStudent(t, studentID), &hpb.SubmitHomeworkRequest{
+			Id:         "hw-1",
+			Submission: &hpb.Submission{StudentId: studentID, PartnersId: partners},
+		})
+
+		return resp.GetSubmission(), err
+	}
+
+	for name, partners := range map[string][]string{
+		"own partner":   {"student-1"},
+		"listed twice":  {"student-2", "student-2"},
+		"empty partner": {""},
+		"too large":     {"student-2", "student-3"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := submit("student-1", partners...)
+			wantCode(t, err, codes.InvalidArgument)
+		})
+	}
+
+	first, err := submit("student-1", "student-2")
+	if err != nil {
+		t.Fatalf("SubmitHomework: %v", err)
+	}
+
+	if first.GetGroupId() == "" {
+		t.Fatal("SubmitHomework with partners formed no group")
+	}
+
+	// any member continues the group's version history without naming the partners again.
+	second, err := submit("student-2")
+	if err != nil {
+		t.Fatalf("SubmitHomework: %v", err)
+	}
+
+	if second.GetGroupId() != first.GetGroupId() || second.GetVersion() != 2 ||
+		len(second.GetPartnersId()) != 1 || second.GetPartnersId()[0] != "student-1" {
+		t.Fatalf("SubmitHomework by a partner = %v, want version 2 of group %s", second, first.GetGroupId())
+	}
+
+	if _, err := submit("student-4"); err != nil {
+		t.Fatalf("SubmitHomework: %v", err)
+	}
+
+	for name, call := range map[string]func() error{
+		"joining another group": func() error {
+			_, err := submit("student-3", "student-2")
+			return err
+		},
+		"changing the partners": func() error {
+			_, err := submit("student-1", "student-3")
+			return err
+		},
+		"after submitting individually": func() error {
+			_, err := submit("student-5", "student-4")
+			return err
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			wantCode(t, call(), codes.FailedPrecondition)
+		})
+	}
+
+	// partners see the group's submissions.
+	got, err := course.client.GetSubmissions(course.asStudent(t, "student-2"),
+		&hpb.GetSubmissionsRequest{HomeworkId: "hw-1"})
+	if err != nil {
+		t.Fatalf("GetSubmissions: %v", err)
+	}
+
+	if len(got.GetSubmissions()) != 1 || got.GetSubmissions()[0].GetId() != second.GetId() {
+		t.Fatalf("GetSubmissions returned %v to a partner, want the group's final submission", got.GetSubmissions())
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// penalties are expressed as percentages of the score.
+	maxPenaltyPercent = 100
+	penaltyDay        = 24 * time.Hour
+)
+
+// lateAssessment is the outcome of applying a homework's late policy to a submission.
+type lateAssessment struct {
+	isLate  bool
+	lateBy  time.Duration
+	penalty float64
+	// pastCutoff is set when the submission was made after the policy's hard cutoff.
+	pastCutoff bool
+}
+
+// assessLateness applies the late policy to a submission made at submitted against the due date.
+// Homeworks without a due date are never late; a nil policy accepts late submissions without penalty.
+func assessLateness(due time.Time, policy *hpb.LatePolicy, submitted time.Time) lateAssessment {
+	if due.IsZero() || !submitted.After(due) {
+		return lateAssessment{}
+	}
+
+	assessment := lateAssessment{isLate: true, lateBy: submitted.Sub(due)}
+
+	if cutoff := policy.GetHardCutoff(); cutoff != nil && assessment.lateBy > cutoff.AsDuration() {
+		assessment.pastCutoff = true
+	}
+
+	penalized := assessment.lateBy - policy.GetGracePeriod().AsDuration()
+	if penalized <= 0 || policy.GetPenaltyPerDay() == 0 {
+		return assessment
+	}
+
+	// every started day counts as a full day.
+	days := math.Ceil(float64(penalized) / float64(penaltyDay))
+
+	limit := float64(maxPenaltyPercent)
+	if policy.GetMaxPenalty() > 0 {
+		limit = policy.GetMaxPenalty()
+	}
+
+	assessment.penalty = math.Min(days*policy.GetPenaltyPerDay(), limit)
+
+	return assessment
+}
+
+// validateLatePolicy checks that a late policy's durations and percentages are in range.
+func validateLatePolicy(policy *hpb.LatePolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	for _, d := range []*durationpb.Duration{policy.GetGracePeriod(), policy.GetHardCutoff()} {
+		if d == nil {
+			continue
+		}
+
+		if err := d.CheckValid(); err != nil {
+			return err
+		}
+
+		if d.AsDuration() < 0 {
+			return errors.New("durations must not be negative")
+		}
+	}
+
+	for _, percent := range []float64{policy.GetPenaltyPerDay(), policy.GetMaxPenalty()} {
+		if percent < 0 || percent > maxPenaltyPercent {
+			return errors.New("percentages must be between 0 and 100")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAssessLateness(t *testing.T) {
+	due := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	policy := &hpb.LatePolicy{
+		GracePeriod:   durationpb.New(time.Hour),
+		PenaltyPerDay: 10,
+		MaxPenalty:    25,
+		HardCutoff:    durationpb.New(5 * penaltyDay),
+	}
+
+	tests := []struct {
+		name      string
+		due       time.Time
+		policy    *hpb.LatePolicy
+		submitted time.Time
+		want      lateAssessment
+	}{
+		{"on time", due, policy, due, lateAssessment{}},
+		{"no due date", time.Time{}, policy, due, lateAssessment{}},
+		{"within the grace period", due, policy, due.Add(time.Hour), lateAssessment{isLate: true, lateBy: time.Hour}},
+		{"a started day counts in full", due, policy, due.Add(2 * time.Hour), lateAssessment{
+			isLate: true, lateBy: 2 * time.Hour, penalty: 10,
+		}},
+		{"capped penalty", due, policy, due.Add(4 * penaltyDay), lateAssessment{
+			isLate: true, lateBy: 4 * penaltyDay, penalty: 25,
+		}},
+		{"past the cutoff", due, policy, due.Add(6 * penaltyDay), lateAssessment{
+			isLate: true, lateBy: 6 * penaltyDay, penalty: 25, pastCutoff: true,
+		}},
+		{"no policy", due, nil, due.Add(6 * penaltyDay), lateAssessment{isLate: true, lateBy: 6 * penaltyDay}},
+		{"default cap", due, &hpb.LatePolicy{PenaltyPerDay: 30}, due.Add(6 * penaltyDay), lateAssessment{
+			isLate: true, lateBy: 6 * penaltyDay, penalty: maxPenaltyPercent,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := assessLateness(tt.due, tt.policy, tt.submitted); got != tt.want {
+				t.Fatalf("assessLateness = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateLatePolicy(t *testing.T) {
+	valid := []*hpb.LatePolicy{
+		nil,
+		{},
+		{GracePeriod: durationpb.New(time.Hour), PenaltyPerDay: 10, MaxPenalty: 100, HardCutoff: durationpb.New(0)},
+	}
+
+	for _, policy := range valid {
+		if err := validateLatePolicy(policy); err != nil {
+			t.Errorf("validateLatePolicy(%v) = %v", policy, err)
+		}
+	}
+
+	invalid := []*hpb.LatePolicy{
+		{GracePeriod: durationpb.New(-time.Hour)},
+		{HardCutoff: &durationpb.Duration{Seconds: 1, Nanos: -1}},
+		{PenaltyPerDay: -1},
+		{MaxPenalty: 101},
+	}
+
+	for _, policy := range invalid {
+		if err := validateLatePolicy(policy); err == nil {
+			t.Errorf("validateLatePolicy(%v) accepted an invalid policy", policy)
+		}
+	}
+}
+
+func TestLatePolicy(t *testing.T) {
+	due := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	homework := newTestHomework("hw-1")
+	homework.DueDate = timestamppb.New(due)
+	homework.MaxAttempts = 0
+	homework.LatePolicy = &hpb.LatePolicy{
+		GracePeriod:   durationpb.New(time.Hour),
+		PenaltyPerDay: 10,
+		MaxPenalty:    25,
+		HardCutoff:    durationpb.New(72 * time.Hour),
+	}
+
+	course := newCourseTest(t, homework)
+	client, student := course.client, course.student
+
+	tests := []struct {
+		name    string
+		after   time.Duration
+		late    bool
+		penalty float64
+	}{
+		{"on time", -time.Minute, false, 0},
+		{"at the due date", 0, false, 0},
+		{"within the grace period", 30 * time.Minute, true, 0},
+		{"first day", time.Hour + time.Minute, true, 10},
+		{"second day", 25*time.Hour + time.Minute, true, 20},
+		{"capped", 50 * time.Hour, true, 25},
+		{"at the cutoff", 72 * time.Hour, true, 25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			course.server.now = func() time.Time { return due.Add(tt.after) }
+
+			resp, err := client.SubmitHomework(student, &hpb.SubmitHomeworkRequest{
+				Id:         "hw-1",
+				Submission: &hpb.Submission{StudentId: "student-1", SubmissionTime: timestamppb.New(due)},
+			})
+			if err != nil {
+				t.Fatalf("SubmitHomework: %v", err)
+			}
+
+			submission := resp.GetSubmission()
+
+			// the submission time is the server's, whatever the client sent.
+			if !submission.GetSubmissionTime().AsTime().Equal(due.Add(tt.after)) {
+				t.Fatalf("SubmitHomework recorded time %v, want %v", submission.GetSubmissionTime().AsTime(),
+					due.Add(tt.after))
+			}
+
+			if submission.GetIsLate() != tt.late || submission.GetPenaltyPercent() != tt.penalty {
+				t.Fatalf("SubmitHomework = late %v with penalty %v, want late %v with penalty %v",
+					submission.GetIsLate(), submission.GetPenaltyPercent(), tt.late, tt.penalty)
+			}
+
+			if tt.late && submission.GetLateBy().AsDuration() != tt.after {
+				t.Fatalf("SubmitHomework = late by %v, want %v", submission.GetLateBy().AsDuration(), tt.after)
+			}
+		})
+	}
+
+	course.server.now = func() time.Time { return due.Add(72*time.Hour + time.Second) }
+
+	_, err := client.SubmitHomework(student, &hpb.SubmitHomeworkRequest{
+		Id:         "hw-1",
+		Submission: &hpb.Submission{StudentId: "student-1"},
+	})
+	wantCode(t, err, codes.FailedPrecondition)
+}
@@ -0,0 +1,992 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"github.com/google/uuid"
+	"k8s.io/klog/v2"
+)
+
+// studentKey identifies the records of a student on a homework.
+type studentKey struct {
+	homeworkID string
+	studentID  string
+}
+
+// MemoryStorage keeps everything in process memory, for tests and local demos.
+// It reuses the database models and follows the semantics of the PostgreSQL storage.
+type MemoryStorage struct {
+	mu          sync.Mutex
+	homeworks   map[string]*Homework
+	files       map[string]*StoredFile
+	submissions []*Submission
+	members     map[studentKey]*GroupMember
+	extensions  map[studentKey]*Extension
+	grades      map[string]*Grade
+	regrades    []*Regrade
+	progress    map[studentKey]*WorkflowProgress
+}
+
+// NewMemoryStorage creates an empty in-memory storage.
+func NewMemoryStorage() *MemoryStorage {
+	klog.Info("Using in-memory storage.")
+
+	return &MemoryStorage{
+		homeworks:  make(map[string]*Homework),
+		files:      make(map[string]*StoredFile),
+		members:    make(map[studentKey]*GroupMember),
+		extensions: make(map[studentKey]*Extension),
+		grades:     make(map[string]*Grade),
+		progress:   make(map[studentKey]*WorkflowProgress),
+	}
+}
+
+// AddHomework implements Storage.AddHomework.
+func (m *MemoryStorage) AddHomework(_ context.Context, homework *hpb.Homework) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.homeworks[homework.GetId()]; ok {
+		return fmt.Errorf("failed to insert homework: %w", errAlreadyExists)
+	}
+
+	model := newHomework(homework)
+	model.CreatedAt = time.Now()
+	model.Version = 1
+	m.homeworks[model.ID] = model
+	homework.Version = model.Version
+
+	return nil
+}
+
+// GetHomework implements Storage.GetHomework.
+func (m *MemoryStorage) GetHomework(_ context.Context, id string) (*hpb.Homework, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	homework, ok := m.homeworks[id]
+	if !ok {
+		return nil, fmt.Errorf("failed to get homework: %w", sql.ErrNoRows)
+	}
+
+	return homework.toProto(), nil
+}
+
+// compareHomeworks compares homeworks in the order they are listed: by the time they are
+// ordered by and then by ID, reversed when descending. Undated homeworks come after every
+// dated one in either direction, like the leading due_date IS NULL key of the database.
+func compareHomeworks(a, b *Homework, order hpb.HomeworkOrder, descending bool) int {
+	sign := 1
+	if descending {
+		sign = -1
+	}
+
+	if order == hpb.HomeworkOrder_HOMEWORK_ORDER_CREATED_AT {
+		return sign * cmp.Or(a.CreatedAt.Compare(b.CreatedAt), strings.Compare(a.ID, b.ID))
+	}
+
+	if aUndated, bUndated := a.DueDate.IsZero(), b.DueDate.IsZero(); aUndated != bUndated {
+		if aUndated {
+			return 1
+		}
+
+		return -1
+	}
+
+	return sign * cmp.Or(a.DueDate.Compare(b.DueDate), strings.Compare(a.ID, b.ID))
+}
+
+// ListHomeworks implements Storage.ListHomeworks.
+func (m *MemoryStorage) ListHomeworks(_ context.Context,
+	filter *HomeworkFilter,
+) ([]*hpb.Homework, *HomeworkCursor, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	title := strings.ToLower(filter.TitleContains)
+	homeworks := make([]*Homework, 0)
+
+	for _, homework := range m.homeworks {
+		switch {
+		case homework.CourseID != filter.CourseID,
+			!filter.DueAfter.IsZero() && (homework.DueDate.IsZero() || homework.DueDate.Before(filter.DueAfter)),
+			!filter.DueBefore.IsZero() && (homework.DueDate.IsZero() || !homework.DueDate.Before(filter.DueBefore)),
+			!strings.Contains(strings.ToLower(homework.Title), title):
+			continue
+		}
+
+		homeworks = append(homeworks, homework)
+	}
+
+	compare := func(a, b *Homework) int {
+		return compareHomeworks(a, b, filter.OrderBy, filter.Descending)
+	}
+
+	slices.SortFunc(homeworks, compare)
+
+	if filter.After != nil {
+		after := &Homework{ID: filter.After.ID}
+
+		if !filter.After.Undated {
+			key, err := time.Parse(time.RFC3339Nano, filter.After.Key)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to list homeworks: %w", err)
+			}
+
+			after.CreatedAt, after.DueDate = key, key
+		}
+
+		start, _ := slices.BinarySearchFunc(homeworks, after, compare)
+		for start < len(homeworks) && compare(homeworks[start], after) <= 0 {
+			start++
+		}
+
+		homeworks = homeworks[start:]
+	}
+
+	var next *HomeworkCursor
+
+	if len(homeworks) > filter.Limit {
+		homeworks = homeworks[:filter.Limit]
+		last := homeworks[len(homeworks)-1]
+		next = &HomeworkCursor{ID: last.ID}
+
+		switch {
+		case filter.OrderBy == hpb.HomeworkOrder_HOMEWORK_ORDER_CREATED_AT:
+			next.Key = last.CreatedAt.Format(time.RFC3339Nano)
+		case last.DueDate.IsZero():
+			next.Undated = true
+		default:
+			next.Key = last.DueDate.Format(time.RFC3339Nano)
+		}
+	}
+
+	result := make([]*hpb.Homework, 0, len(homeworks))
+	for _, homework := range homeworks {
+		result = append(result, homework.toProto())
+	}
+
+	return result, next, nil
+}
+
+// UpdateHomework implements Storage.UpdateHomework.
+func (m *MemoryStorage) UpdateHomework(_ context.Context, homework *hpb.Homework,
+	columns []string,
+) (*hpb.Homework, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	existing, ok := m.homeworks[homework.GetId()]
+	if !ok {
+		return nil, fmt.Errorf("failed to update homework: %w", sql.ErrNoRows)
+	}
+
+	if existing.Version != homework.GetVersion() {
+		return nil, fmt.Errorf("%w: version %d is no longer current", errStaleVersion, homework.GetVersion())
+	}
+
+	model := newHomework(homework)
+	if columns != nil {
+		model = updateHomeworkColumns(existing, model, columns)
+	}
+
+	model.CreatedAt = existing.CreatedAt
+	model.Version = existing.Version + 1
+	m.homeworks[model.ID] = model
+
+	return model.toProto(), nil
+}
+
+// updateHomeworkColumns returns a copy of the stored homework with the given columns taken
+// from the update.
+func updateHomeworkColumns(stored, update *Homework, columns []string) *Homework {
+	model := *stored
+
+	for _, column := range columns {
+		switch column {
+		case "course_id":
+			model.CourseID = update.CourseID
+		case "title":
+			model.Title = update.Title
+		case "description":
+			model.Description = update.Description
+		case "files":
+			model.Files = update.Files
+		case "workflow":
+			model.Workflow = update.Workflow
+		case "due_date":
+			model.DueDate = update.DueDate
+		case "late_policy":
+			model.LatePolicy = update.LatePolicy
+		case "max_attempts":
+			model.MaxAttempts = update.MaxAttempts
+		case "max_group_size":
+			model.MaxGroupSize = update.MaxGroupSize
+		case "rubric":
+			model.Rubric = update.Rubric
+		case "workflow_steps":
+			model.WorkflowSteps = update.WorkflowSteps
+		}
+	}
+
+	return &model
+}
+
+// DeleteHomework implements Storage.DeleteHomework.
+func (m *MemoryStorage) DeleteHomework(_ context.Context, id string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.homeworks[id]; !ok {
+		return nil, fmt.Errorf("failed to delete homework: %w", sql.ErrNoRows)
+	}
+
+	delete(m.homeworks, id)
+
+	var contentRefs []string
+
+	for ref, file := range m.files {
+		if file.HomeworkID == id {
+			contentRefs = append(contentRefs, ref)
+			delete(m.files, ref)
+		}
+	}
+
+	// cascade like the foreign keys of the database.
+	m.submissions = slices.DeleteFunc(m.submissions, func(s *Submission) bool {
+		if s.HomeworkID == id {
+			delete(m.grades, s.ID)
+		}
+
+		return s.HomeworkID == id
+	})
+	m.regrades = slices.DeleteFunc(m.regrades, func(r *Regrade) bool { return r.HomeworkID == id })
+
+	for key := range m.members {
+		if key.homeworkID == id {
+			delete(m.members, key)
+		}
+	}
+
+	for key := range m.extensions {
+		if key.homeworkID == id {
+			delete(m.extensions, key)
+		}
+	}
+
+	for key := range m.progress {
+		if key.homeworkID == id {
+			delete(m.progress, key)
+		}
+	}
+
+	return contentRefs, nil
+}
+
+// AddStoredFile implements Storage.AddStoredFile.
+func (m *MemoryStorage) AddStoredFile(_ context.Context, homeworkID, uploadedBy string, ref *FileRef) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.files[ref.ContentRef]; ok {
+		return fmt.Errorf("failed to insert stored file: %w", errAlreadyExists)
+	}
+
+	m.files[ref.ContentRef] = &StoredFile{
+		ContentRef: ref.ContentRef,
+		HomeworkID: homeworkID,
+		UploadedBy: uploadedBy,
+		Filename:   ref.Filename,
+		MimeType:   ref.MimeType,
+		SHA256:     ref.SHA256,
+		Size:       ref.Size,
+		CreatedAt:  time.Now(),
+	}
+
+	return nil
+}
+
+// GetStoredFile implements Storage.GetStoredFile.
+func (m *MemoryStorage) GetStoredFile(_ context.Context, contentRef string) (*StoredFile, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, ok := m.files[contentRef]
+	if !ok {
+		return nil, fmt.Errorf("failed to get stored file: %w", sql.ErrNoRows)
+	}
+
+	stored := *file
+
+	return &stored, nil
+}
+
+// DeleteStoredFiles implements Storage.DeleteStoredFiles.
+func (m *MemoryStorage) DeleteStoredFiles(_ context.Context, contentRefs []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, ref := range contentRefs {
+		delete(m.files, ref)
+	}
+
+	return nil
+}
+
+// groupOf returns the members of a group, sorted. The caller must hold the lock.
+func (m *MemoryStorage) groupOf(groupID string) []string {
+	var members []string
+
+	for _, member := range m.members {
+		if member.GroupID == groupID {
+			members = append(members, member.StudentID)
+		}
+	}
+
+	slices.Sort(members)
+
+	return members
+}
+
+// resolveGroup mirrors resolveGroup of the database. The caller must hold the lock.
+func (m *MemoryStorage) resolveGroup(homeworkID, studentID string, partners []string) (string, []string, error) {
+	members := append([]string{studentID}, partners...)
+
+	var memberships []*GroupMember
+
+	for _, member := range members {
+		if membership, ok := m.members[studentKey{homeworkID, member}]; ok {
+			memberships = append(memberships, membership)
+		}
+	}
+
+	if len(memberships) == 0 {
+		if len(partners) == 0 {
+			return "", nil, nil
+		}
+
+		for _, submission := range m.submissions {
+			if submission.HomeworkID == homeworkID && submission.GroupID == "" &&
+				slices.Contains(members, submission.StudentID) {
+				return "", nil, fmt.Errorf("%w: student %s already submitted individually",
+					errGroupConflict, submission.StudentID)
+			}
+		}
+
+		groupID := uuid.NewString()
+		for _, member := range members {
+			m.members[studentKey{homeworkID, member}] = &GroupMember{
+				ID:         uuid.NewString(),
+				HomeworkID: homeworkID,
+				StudentID:  member,
+				GroupID:    groupID,
+			}
+		}
+
+		return groupID, slices.Clone(partners), nil
+	}
+
+	groupID := ""
+	if membership, ok := m.members[studentKey{homeworkID, studentID}]; ok {
+		groupID = membership.GroupID
+	}
+
+	for _, membership := range memberships {
+		if membership.GroupID != groupID {
+			return "", nil, fmt.Errorf("%w: student %s already belongs to another group",
+				errGroupConflict, membership.StudentID)
+		}
+	}
+
+	group := m.groupOf(groupID)
+
+	if len(partners) > 0 && (len(memberships) != len(members) || len(group) != len(members)) {
+		return "", nil, fmt.Errorf("%w: partners do not match the members of group %s",
+			errGroupConflict, groupID)
+	}
+
+	others := slices.DeleteFunc(group, func(member string) bool { return member == studentID })
+
+	return groupID, others, nil
+}
+
+// AddSubmission implements Storage.AddSubmission.
+func (m *MemoryStorage) AddSubmission(_ context.Context, homeworkID string,
+	submission *hpb.Submission, maxAttempts int32,
+) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.homeworks[homeworkID]; !ok {
+		return fmt.Errorf("failed to get homework: %w", sql.ErrNoRows)
+	}
+
+	groupID, partners, err := m.resolveGroup(homeworkID, submission.GetStudentId(), submission.GetPartnersId())
+	if err != nil {
+		return err
+	}
+
+	submission.GroupId = groupID
+	submission.PartnersId = partners
+	model := newSubmission(homeworkID, submission)
+
+	var latest int32
+
+	for _, existing := range m.submissions {
+		if existing.HomeworkID == homeworkID && existing.OwnerID == model.OwnerID {
+			latest = max(latest, existing.Version)
+		}
+	}
+
+	if maxAttempts > 0 && latest >= maxAttempts {
+		return fmt.Errorf("%w: %d of %d used", errMaxAttemptsReached, latest, maxAttempts)
+	}
+
+	m.clearFinal(homeworkID, model.OwnerID)
+
+	model.ID = uuid.NewString()
+	model.Version = latest + 1
+	model.Final = true
+	m.submissions = append(m.submissions, model)
+
+	submission.Id = model.ID
+	submission.HomeworkId = homeworkID
+	submission.Version = model.Version
+	submission.Final = true
+
+	return nil
+}
+
+// clearFinal unmarks the final submission of a student or group. The caller must hold the lock.
+func (m *MemoryStorage) clearFinal(homeworkID, ownerID string) {
+	for _, submission := range m.submissions {
+		if submission.HomeworkID == homeworkID && submission.OwnerID == ownerID {
+			submission.Final = false
+		}
+	}
+}
+
+// GroupMembers implements Storage.GroupMembers.
+func (m *MemoryStorage) GroupMembers(_ context.Context, homeworkID, studentID string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	membership, ok := m.members[studentKey{homeworkID, studentID}]
+	if !ok {
+		return nil, nil
+	}
+
+	return m.groupOf(membership.GroupID), nil
+}
+
+// submission returns the submission with the given ID, or nil. The caller must hold the lock.
+func (m *MemoryStorage) submission(id string) *Submission {
+	for _, submission := range m.submissions {
+		if submission.ID == id {
+			return submission
+		}
+	}
+
+	return nil
+}
+
+// GetSubmission implements Storage.GetSubmission.
+func (m *MemoryStorage) GetSubmission(_ context.Context, id string) (*hpb.Submission, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	submission := m.submission(id)
+	if submission == nil {
+		return nil, fmt.Errorf("failed to get submission: %w", sql.ErrNoRows)
+	}
+
+	return submission.toProto(), nil
+}
+
+// SelectFinalSubmission implements Storage.SelectFinalSubmission.
+func (m *MemoryStorage) SelectFinalSubmission(_ context.Context, id string) (*hpb.Submission, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	submission := m.submission(id)
+	if submission == nil {
+		return nil, fmt.Errorf("failed to get submission: %w", sql.ErrNoRows)
+	}
+
+	m.clearFinal(submission.HomeworkID, submission.OwnerID)
+	submission.Final = true
+
+	return submission.toProto(), nil
+}
+
+// madeBy returns whether the student, or one of the student's groups, made the submission.
+// The caller must hold the lock.
+func (m *MemoryStorage) madeBy(submission *Submission, studentID string) bool {
+	if submission.StudentID == studentID {
+		return true
+	}
+
+	membership, ok := m.members[studentKey{submission.HomeworkID, studentID}]
+
+	return ok && submission.GroupID != "" && membership.GroupID == submission.GroupID
+}
+
+// findSubmissions returns the submissions matching the predicate, in insertion order.
+// The caller must hold the lock.
+func (m *MemoryStorage) findSubmissions(match func(*Submission) bool) []*Submission {
+	var result []*Submission
+
+	for _, submission := range m.submissions {
+		if match(submission) {
+			result = append(result, submission)
+		}
+	}
+
+	return result
+}
+
+// inCourses returns whether the homework belongs to one of the courses; nil matches every course.
+// The caller must hold the lock.
+func (m *MemoryStorage) inCourses(homeworkID string, courseIDs []string) bool {
+	homework, ok := m.homeworks[homeworkID]
+
+	return courseIDs == nil || (ok && slices.Contains(courseIDs, homework.CourseID))
+}
+
+// memorySubmissionsToProto converts stored submissions into messages.
+func memorySubmissionsToProto(submissions []*Submission) []*hpb.Submission {
+	result := make([]*hpb.Submission, 0, len(submissions))
+
+	for _, submission := range submissions {
+		result = append(result, submission.toProto())
+	}
+
+	return result
+}
+
+// compareSubmissionTime orders submissions by submission time.
+func compareSubmissionTime(a, b *Submission) int {
+	return a.SubmissionTime.Compare(b.SubmissionTime)
+}
+
+// GetSubmissions implements Storage.GetSubmissions.
+func (m *MemoryStorage) GetSubmissions(_ context.Context, homeworkID, studentID string) ([]*hpb.Submission, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	submissions := m.findSubmissions(func(s *Submission) bool {
+		return s.HomeworkID == homeworkID && s.Final && (studentID == "" || m.madeBy(s, studentID))
+	})
+	slices.SortStableFunc(submissions, compareSubmissionTime)
+
+	return memorySubmissionsToProto(submissions), nil
+}
+
+// HasSubmissionFile implements Storage.HasSubmissionFile.
+func (m *MemoryStorage) HasSubmissionFile(_ context.Context, homeworkID, studentID, contentRef string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.findSubmissions(func(s *Submission) bool {
+		return s.HomeworkID == homeworkID && m.madeBy(s, studentID) &&
+			s.SubmissionFile != nil && s.SubmissionFile.ContentRef == contentRef
+	})) > 0, nil
+}
+
+// GetStudentSubmissions implements Storage.GetStudentSubmissions.
+func (m *MemoryStorage) GetStudentSubmissions(_ context.Context, studentID string,
+	courseIDs []string,
+) ([]*hpb.Submission, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	submissions := m.findSubmissions(func(s *Submission) bool {
+		return s.Final && m.madeBy(s, studentID) && m.inCourses(s.HomeworkID, courseIDs)
+	})
+	slices.SortStableFunc(submissions, func(a, b *Submission) int {
+		return cmp.Or(strings.Compare(a.HomeworkID, b.HomeworkID), compareSubmissionTime(a, b))
+	})
+
+	return memorySubmissionsToProto(submissions), nil
+}
+
+// ListSubmissionVersions implements Storage.ListSubmissionVersions.
+func (m *MemoryStorage) ListSubmissionVersions(_ context.Context,
+	homeworkID, studentID string,
+) ([]*hpb.Submission, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	owner := studentID
+	if membership, ok := m.members[studentKey{homeworkID, studentID}]; ok {
+		owner = membership.GroupID
+	}
+
+	submissions := m.findSubmissions(func(s *Submission) bool {
+		return s.HomeworkID == homeworkID && s.OwnerID == owner
+	})
+	slices.SortStableFunc(submissions, func(a, b *Submission) int { return cmp.Compare(a.Version, b.Version) })
+
+	return memorySubmissionsToProto(submissions), nil
+}
+
+// GrantExtensions implements Storage.GrantExtensions.
+func (m *MemoryStorage) GrantExtensions(_ context.Context, extensions []*hpb.Extension) ([]*hpb.Extension, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make([]*hpb.Extension, 0, len(extensions))
+
+	for _, extension := range extensions {
+		if _, ok := m.homeworks[extension.GetHomeworkId()]; !ok {
+			return nil, fmt.Errorf("failed to grant extensions: %w", sql.ErrNoRows)
+		}
+	}
+
+	for _, extension := range extensions {
+		key := studentKey{extension.GetHomeworkId(), extension.GetStudentId()}
+		model := &Extension{
+			ID:         uuid.NewString(),
+			HomeworkID: key.homeworkID,
+			StudentID:  key.studentID,
+			DueDate:    fromTimestamp(extension.GetDueDate()),
+			Reason:     extension.GetReason(),
+			GrantedAt:  time.Now(),
+		}
+
+		if existing, ok := m.extensions[key]; ok {
+			model.ID = existing.ID
+		}
+
+		m.extensions[key] = model
+		result = append(result, model.toProto())
+	}
+
+	return result, nil
+}
+
+// RevokeExtensions implements Storage.RevokeExtensions.
+func (m *MemoryStorage) RevokeExtensions(_ context.Context, homeworkID string, studentIDs []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, studentID := range studentIDs {
+		delete(m.extensions, studentKey{homeworkID, studentID})
+	}
+
+	return nil
+}
+
+// ListExtensions implements Storage.ListExtensions.
+func (m *MemoryStorage) ListExtensions(_ context.Context, homeworkID string) ([]*hpb.Extension, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var extensions []*Extension
+
+	for key, extension := range m.extensions {
+		if key.homeworkID == homeworkID {
+			extensions = append(extensions, extension)
+		}
+	}
+
+	slices.SortFunc(extensions, func(a, b *Extension) int { return strings.Compare(a.StudentID, b.StudentID) })
+
+	result := make([]*hpb.Extension, 0, len(extensions))
+	for _, extension := range extensions {
+		result = append(result, extension.toProto())
+	}
+
+	return result, nil
+}
+
+// GetExtension implements Storage.GetExtension.
+func (m *MemoryStorage) GetExtension(_ context.Context, homeworkID, studentID string) (*hpb.Extension, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	extension, ok := m.extensions[studentKey{homeworkID, studentID}]
+	if !ok {
+		return nil, fmt.Errorf("failed to get extension: %w", sql.ErrNoRows)
+	}
+
+	return extension.toProto(), nil
+}
+
+// gradeToProto converts a stored grade, with its current submission, into a message.
+// The caller must hold the lock.
+func (m *MemoryStorage) gradeToProto(grade *Grade) *hpb.Grade {
+	loaded := *grade
+	loaded.Submission = m.submission(grade.SubmissionID)
+
+	return loaded.toProto()
+}
+
+// SetGrade implements Storage.SetGrade.
+func (m *MemoryStorage) SetGrade(_ context.Context, grade *hpb.Grade) (*hpb.Grade, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.submission(grade.GetSubmissionId()) == nil {
+		return nil, fmt.Errorf("failed to get submission: %w", sql.ErrNoRows)
+	}
+
+	now := time.Now()
+	model := &Grade{
+		ID:           uuid.NewString(),
+		SubmissionID: grade.GetSubmissionId(),
+		Score:        grade.GetScore(),
+		MaxScore:     grade.GetMaxScore(),
+		Feedback:     grade.GetFeedback(),
+		GradedAt:     now,
+		UpdatedAt:    now,
+	}
+
+	for _, selection := range grade.GetSelections() {
+		model.Selections = append(model.Selections, CriterionSelection{
+			CriterionID: selection.GetCriterionId(),
+			LevelID:     selection.GetLevelId(),
+			Comment:     selection.GetComment(),
+			Points:      selection.GetPoints(),
+		})
+	}
+
+	if existing, ok := m.grades[model.SubmissionID]; ok {
+		model.ID, model.GradedAt = existing.ID, existing.GradedAt
+	}
+
+	m.grades[model.SubmissionID] = model
+
+	return m.gradeToProto(model), nil
+}
+
+// findGrades returns the grades of the final submissions matching the predicate, in
+// submission order. The caller must hold the lock.
+func (m *MemoryStorage) findGrades(match func(*Submission) bool) []*Grade {
+	var grades []*Grade
+
+	for _, submission := range m.submissions {
+		if grade, ok := m.grades[submission.ID]; ok && submission.Final && match(submission) {
+			grades = append(grades, grade)
+		}
+	}
+
+	return grades
+}
+
+// GetGrades implements Storage.GetGrades.
+func (m *MemoryStorage) GetGrades(_ context.Context, homeworkID string) ([]*hpb.Grade, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	grades := m.findGrades(func(s *Submission) bool { return s.HomeworkID == homeworkID })
+	slices.SortStableFunc(grades, func(a, b *Grade) int {
+		return compareSubmissionTime(m.submission(a.SubmissionID), m.submission(b.SubmissionID))
+	})
+
+	result := make([]*hpb.Grade, 0, len(grades))
+	for _, grade := range grades {
+		result = append(result, m.gradeToProto(grade))
+	}
+
+	return result, nil
+}
+
+// GetStudentGrades implements Storage.GetStudentGrades.
+func (m *MemoryStorage) GetStudentGrades(_ context.Context, studentID string,
+	courseIDs []string,
+) ([]*hpb.Grade, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	grades := m.findGrades(func(s *Submission) bool {
+		return m.madeBy(s, studentID) && m.inCourses(s.HomeworkID, courseIDs)
+	})
+	slices.SortStableFunc(grades, func(a, b *Grade) int {
+		return strings.Compare(m.submission(a.SubmissionID).HomeworkID, m.submission(b.SubmissionID).HomeworkID)
+	})
+
+	result := make([]*hpb.Grade, 0, len(grades))
+	for _, grade := range grades {
+		result = append(result, m.gradeToProto(grade))
+	}
+
+	return result, nil
+}
+
+// AddRegrade implements Storage.AddRegrade.
+func (m *MemoryStorage) AddRegrade(_ context.Context, regrade *hpb.Regrade) (*hpb.Regrade, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	grade, ok := m.grades[regrade.GetSubmissionId()]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errNotGraded, regrade.GetSubmissionId())
+	}
+
+	open := hpb.RegradeStatus_REGRADE_STATUS_OPEN.String()
+
+	for _, existing := range m.regrades {
+		if existing.SubmissionID == grade.SubmissionID && existing.Status == open {
+			return nil, fmt.Errorf("%w: %s", errRegradeOpen, grade.SubmissionID)
+		}
+	}
+
+	model := &Regrade{
+		ID:            uuid.NewString(),
+		SubmissionID:  grade.SubmissionID,
+		HomeworkID:    m.submission(grade.SubmissionID).HomeworkID,
+		StudentID:     regrade.GetStudentId(),
+		Justification: regrade.GetJustification(),
+		Status:        open,
+		OriginalScore: grade.Score,
+		MaxScore:      grade.MaxScore,
+		CreatedAt:     time.Now(),
+	}
+	m.regrades = append(m.regrades, model)
+
+	return model.toProto(), nil
+}
+
+// GetRegrade implements Storage.GetRegrade.
+func (m *MemoryStorage) GetRegrade(_ context.Context, id string) (*hpb.Regrade, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, regrade := range m.regrades {
+		if regrade.ID == id {
+			return regrade.toProto(), nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to get regrade request: %w", sql.ErrNoRows)
+}
+
+// ResolveRegrade implements Storage.ResolveRegrade.
+func (m *MemoryStorage) ResolveRegrade(_ context.Context,
+	resolution *hpb.Regrade,
+) (*hpb.Regrade, *hpb.Grade, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	index := slices.IndexFunc(m.regrades, func(r *Regrade) bool {
+		return r.ID == resolution.GetId() && r.Status == hpb.RegradeStatus_REGRADE_STATUS_OPEN.String()
+	})
+	if index < 0 {
+		return nil, nil, fmt.Errorf("%w: %s", errRegradeResolved, resolution.GetId())
+	}
+
+	regrade := m.regrades[index]
+
+	// check everything before changing anything, as the database does in its transaction.
+	grade, graded := m.grades[regrade.SubmissionID]
+	if resolution.AdjustedScore != nil && !graded {
+		return nil, nil, fmt.Errorf("failed to update grade: %w", sql.ErrNoRows)
+	}
+
+	now := time.Now()
+	regrade.Status = resolution.GetStatus().String()
+	regrade.Response = resolution.GetResponse()
+	regrade.AdjustedScore = resolution.AdjustedScore
+	regrade.ResolvedAt = now
+
+	if regrade.AdjustedScore == nil {
+		return regrade.toProto(), nil, nil
+	}
+
+	grade.Score = *regrade.AdjustedScore
+	grade.Selections = nil
+	grade.UpdatedAt = now
+
+	return regrade.toProto(), m.gradeToProto(grade), nil
+}
+
+// ListOpenRegrades implements Storage.ListOpenRegrades.
+func (m *MemoryStorage) ListOpenRegrades(_ context.Context, courseID string) ([]*hpb.Regrade, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make([]*hpb.Regrade, 0)
+
+	// regrades are appended in creation order.
+	for _, regrade := range m.regrades {
+		if regrade.Status == hpb.RegradeStatus_REGRADE_STATUS_OPEN.String() &&
+			m.inCourses(regrade.HomeworkID, []string{courseID}) {
+			result = append(result, regrade.toProto())
+		}
+	}
+
+	return result, nil
+}
+
+// AdvanceWorkflow implements Storage.AdvanceWorkflow.
+func (m *MemoryStorage) AdvanceWorkflow(_ context.Context, homeworkID, studentID, step string,
+	steps []string, completedAt time.Time,
+) (*hpb.WorkflowProgress, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.homeworks[homeworkID]; !ok {
+		return nil, fmt.Errorf("failed to get homework: %w", sql.ErrNoRows)
+	}
+
+	key := studentKey{homeworkID, studentID}
+
+	progress, ok := m.progress[key]
+	if !ok {
+		progress = &WorkflowProgress{
+			ID:         uuid.NewString(),
+			HomeworkID: homeworkID,
+			StudentID:  studentID,
+			Completed:  []StepCompletion{},
+		}
+	}
+
+	next, ok := nextWorkflowStep(steps, progress.Completed)
+	if !ok {
+		return nil, errWorkflowDone
+	}
+
+	if next != step {
+		return nil, fmt.Errorf("%w: the next step is %q", errStepOutOfOrder, next)
+	}
+
+	progress.Completed = append(progress.Completed, StepCompletion{Step: step, CompletedAt: completedAt})
+	progress.UpdatedAt = time.Now()
+	m.progress[key] = progress
+
+	return progress.toProto(), nil
+}
+
+// ListWorkflowProgress implements Storage.ListWorkflowProgress.
+func (m *MemoryStorage) ListWorkflowProgress(_ context.Context,
+	homeworkID, studentID string,
+) ([]*hpb.WorkflowProgress, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var progress []*WorkflowProgress
+
+	for key, p := range m.progress {
+		if key.homeworkID == homeworkID && (studentID == "" || key.studentID == studentID) {
+			progress = append(progress, p)
+		}
+	}
+
+	slices.SortFunc(progress, func(a, b *WorkflowProgress) int { return strings.Compare(a.StudentID, b.StudentID) })
+
+	result := make([]*hpb.WorkflowProgress, 0, len(progress))
+	for _, p := range progress {
+		result = append(result, p.toProto())
+	}
+
+	return result, nil
+}
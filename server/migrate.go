@@ -0,0 +1,580 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/migrate"
+	"k8s.io/klog/v2"
+)
+
+// migrationsLock names the advisory lock that keeps replicas starting together from
+// migrating the database at the same time.
+const migrationsLock = "homework-microservice-migrations"
+
+// baselineMigration is the version of the migration creating the schema. Rolling it back
+// would drop every record, so Rollback refuses to.
+const baselineMigration = "20261017100100"
+
+// sqlMigrations holds the SQL migrations, named <version>_<comment>.up.sql and .down.sql;
+// a .tx infix runs them in a transaction.
+//
+//go:embed migrations/*.sql
+var sqlMigrations embed.FS
+
+// newMigrations collects the schema migrations in the order they apply.
+func newMigrations() (*migrate.Migrations, error) {
+	migrations := migrate.NewMigrations()
+
+	// runs before the baseline, whose indexes need the typed columns.
+	migrations.Add(migrate.Migration{
+		Name:    "20261017100000",
+		Comment: "legacy_timestamps",
+		Up:      migrateLegacyTimestamps,
+		Down:    keepMigratedData,
+	})
+
+	dir, err := fs.Sub(sqlMigrations, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open migrations: %w", err)
+	}
+
+	if err := migrations.Discover(dir); err != nil {
+		return nil, fmt.Errorf("failed to discover migrations: %w", err)
+	}
+
+	// run after the baseline, which creates the submissions table.
+	migrations.Add(migrate.Migration{
+		Name:    "20261017100200",
+		Comment: "legacy_submissions",
+		Up:      migrateLegacySubmissions,
+		Down:    keepMigratedData,
+	})
+	migrations.Add(migrate.Migration{
+		Name:    "20261017100300",
+		Comment: "inline_files",
+		Up:      migrateInlineFiles,
+		Down:    keepMigratedData,
+	})
+
+	return migrations, nil
+}
+
+// migrator creates a migrator of the database, creating its migrations table if needed.
+func (d *Database) migrator(ctx context.Context) (*migrate.Migrator, error) {
+	migrations, err := newMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	migrator := migrate.NewMigrator(d.db, migrations, migrate.WithMarkAppliedOnSuccess(true))
+	if err := migrator.Init(ctx); err != nil {
+		return nil, fmt.Errorf("failed to create migrations table: %w", err)
+	}
+
+	return migrator, nil
+}
+
+// withMigrationsLock runs f holding the migrations lock, waiting for other replicas to release it.
+func (d *Database) withMigrationsLock(ctx context.Context, f func(*migrate.Migrator) error) error {
+	migrator, err := d.migrator(ctx)
+	if err != nil {
+		return err
+	}
+
+	// session locks belong to a connection, so the lock is taken on one held apart from the pool.
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock(hashtext(?))", migrationsLock); err != nil {
+		return fmt.Errorf("failed to lock migrations: %w", err)
+	}
+
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock(hashtext(?))",
+			migrationsLock); err != nil {
+			klog.Errorf("Failed to unlock migrations: %v", err)
+		}
+	}()
+
+	return f(migrator)
+}
+
+// Migrate applies the pending migrations and returns them; the group is empty when the
+// schema is up to date.
+func (d *Database) Migrate(ctx context.Context) (*migrate.MigrationGroup, error) {
+	var group *migrate.MigrationGroup
+
+	err := d.withMigrationsLock(ctx, func(migrator *migrate.Migrator) error {
+		var err error
+
+		group, err = migrator.Migrate(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to apply migrations: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if group.IsZero() {
+		klog.Info("Database schema is up to date.")
+	} else {
+		klog.Infof("Database migrated to %s.", group)
+	}
+
+	return group, nil
+}
+
+// Rollback reverts the last group of applied migrations and returns it. A group holding the
+// baseline is left applied, since reverting it would drop every record.
+func (d *Database) Rollback(ctx context.Context) (*migrate.MigrationGroup, error) {
+	var group *migrate.MigrationGroup
+
+	err := d.withMigrationsLock(ctx, func(migrator *migrate.Migrator) error {
+		migrations, err := migrator.MigrationsWithStatus(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get migration status: %w", err)
+		}
+
+		// checked up front so that no migration of the group is reverted either.
+		last := migrations.LastGroup()
+		for _, migration := range last.Migrations {
+			if migration.Name == baselineMigration {
+				return fmt.Errorf("refusing to roll back %s: reverting the baseline would drop every record; "+
+					"drop the database instead to start over", last)
+			}
+		}
+
+		group, err = migrator.Rollback(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to roll back migrations: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if group.IsZero() {
+		klog.Info("There are no migrations to roll back.")
+	} else {
+		klog.Infof("Rolled back %s.", group)
+	}
+
+	return group, nil
+}
+
+// MigrationStatus returns every known migration, with the applied ones marked.
+func (d *Database) MigrationStatus(ctx context.Context) (migrate.MigrationSlice, error) {
+	migrator, err := d.migrator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations, err := migrator.MigrationsWithStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get migration status: %w", err)
+	}
+
+	return migrations, nil
+}
+
+// runMigrateCommand runs the migrate subcommand of the server binary: "up" applies pending
+// migrations, "down" rolls back the last group unless it holds the baseline and "status" lists them.
+func runMigrateCommand(ctx context.Context, command string) error {
+	if command != "up" && command != "down" && command != "status" {
+		return fmt.Errorf("unknown migrate command %q; use up, down or status", command)
+	}
+
+	createDatabaseIfNotExists()
+
+	database, err := ConnectDB()
+	if err != nil {
+		return err
+	}
+	defer database.db.Close()
+
+	switch command {
+	case "up":
+		_, err = database.Migrate(ctx)
+	case "down":
+		_, err = database.Rollback(ctx)
+	case "status":
+		var migrations migrate.MigrationSlice
+
+		migrations, err = database.MigrationStatus(ctx)
+		for _, migration := range migrations {
+			state := "pending"
+			if migration.IsApplied() {
+				state = fmt.Sprintf("applied at %s", migration.MigratedAt.Format("2006-01-02 15:04:05"))
+			}
+
+			fmt.Printf("%s\t%s\n", migration, state) //nolint:forbidigo // command output.
+		}
+	}
+
+	return err
+}
+
+// keepMigratedData is the Down of the data migrations, which are one-way: the records they
+// converted stay converted, which the schema before them reads as well.
+func keepMigratedData(context.Context, *bun.DB) error {
+	return nil
+}
+
+// migrateLegacyTimestamps converts the due dates that older versions stored as free-form
+// text into a timestamptz column. Values that cannot be parsed become NULL and are logged
+// so they can be fixed by hand.
+func migrateLegacyTimestamps(ctx context.Context, db *bun.DB) error {
+	var dataType string
+
+	err := db.NewSelect().TableExpr("information_schema.columns").Column("data_type").
+		Where("table_schema = current_schema()").Where("table_name = 'homeworks'").
+		Where("column_name = 'due_date'").Scan(ctx, &dataType)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && dataType != "character varying") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to inspect homeworks.due_date: %w", err)
+	}
+
+	if err := db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return migrateDueDates(ctx, tx)
+	}); err != nil {
+		return fmt.Errorf("failed to migrate homeworks.due_date: %w", err)
+	}
+
+	klog.Info("Migrated homeworks.due_date to timestamptz.")
+
+	return nil
+}
+
+// migrateDueDates parses every due date of the text column and changes it to timestamptz.
+func migrateDueDates(ctx context.Context, tx bun.Tx) error {
+	var ids, values []string
+
+	if err := tx.NewSelect().Table("homeworks").Column("id", "due_date").Scan(ctx, &ids, &values); err != nil {
+		return fmt.Errorf("failed to read values: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "ALTER TABLE homeworks ADD COLUMN due_date_parsed timestamptz"); err != nil {
+		return fmt.Errorf("failed to add column: %w", err)
+	}
+
+	for i, value := range values {
+		parsed, err := parseLegacyTime(value)
+		if err != nil {
+			klog.Warningf("Clearing unparsable due date of homework %s: %v", ids[i], err)
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, "UPDATE homeworks SET due_date_parsed = ? WHERE id = ?",
+			parsed, ids[i]); err != nil {
+			return fmt.Errorf("failed to update value: %w", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "ALTER TABLE homeworks ALTER COLUMN due_date DROP NOT NULL, "+
+		"ALTER COLUMN due_date TYPE timestamptz USING due_date_parsed"); err != nil {
+		return fmt.Errorf("failed to change column type: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, "ALTER TABLE homeworks DROP COLUMN due_date_parsed"); err != nil {
+		return fmt.Errorf("failed to drop column: %w", err)
+	}
+
+	return nil
+}
+
+// legacySubmission is a submission as earlier versions stored it, inline in its homework.
+type legacySubmission struct {
+	StudentID      string          `json:"studentId"`
+	SubmissionTime string          `json:"submissionTime"`
+	SubmissionFile json.RawMessage `json:"submissionFile"`
+	PartnersID     []string        `json:"partnersId"`
+}
+
+// migrateLegacySubmissions moves the submissions that earlier versions kept in an array column
+// of their homework into the submissions table and drops the column. Each becomes the next
+// version of its student's submissions, in array order, and the latest is final unless the
+// student already chose one. Their files keep their inline content for the inline_files migration.
+func migrateLegacySubmissions(ctx context.Context, db *bun.DB) error {
+	exists, err := db.NewSelect().TableExpr("information_schema.columns").
+		Where("table_schema = current_schema()").Where("table_name = 'homeworks'").
+		Where("column_name = 'submissions'").Exists(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to inspect homeworks.submissions: %w", err)
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var ids, values []string
+
+		if err := tx.NewSelect().Table("homeworks").Column("id").ColumnExpr("array_to_json(submissions)::text").
+			Where("submissions IS NOT NULL").Scan(ctx, &ids, &values); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to read legacy submissions: %w", err)
+		}
+
+		moved := 0
+
+		for i, value := range values {
+			var submissions []legacySubmission
+			if err := json.Unmarshal([]byte(value), &submissions); err != nil {
+				return fmt.Errorf("failed to decode submissions of homework %s: %w", ids[i], err)
+			}
+
+			for _, submission := range submissions {
+				if err := insertLegacySubmission(ctx, tx, ids[i], submission); err != nil {
+					return fmt.Errorf("failed to move submission of homework %s: %w", ids[i], err)
+				}
+			}
+
+			moved += len(submissions)
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE submissions SET final = true
+WHERE version = (SELECT MAX(version) FROM submissions AS latest
+        WHERE latest.homework_id = submissions.homework_id AND latest.owner_id = submissions.owner_id)
+    AND NOT EXISTS (SELECT 1 FROM submissions AS chosen
+        WHERE chosen.homework_id = submissions.homework_id AND chosen.owner_id = submissions.owner_id
+            AND chosen.final)`); err != nil {
+			return fmt.Errorf("failed to mark final submissions: %w", err)
+		}
+
+		if _, err := tx.ExecContext(ctx, "ALTER TABLE homeworks DROP COLUMN submissions"); err != nil {
+			return fmt.Errorf("failed to drop homeworks.submissions: %w", err)
+		}
+
+		klog.Infof("Moved %d legacy submissions to the submissions table.", moved)
+
+		return nil
+	})
+}
+
+// insertLegacySubmission stores a legacy submission of the homework as the next version of its
+// student's submissions.
+func insertLegacySubmission(ctx context.Context, tx bun.Tx, homeworkID string, submission legacySubmission) error {
+	var submissionTime *time.Time
+
+	if submission.SubmissionTime != "" {
+		parsed, err := parseLegacyTime(submission.SubmissionTime)
+		if err != nil {
+			klog.Warningf("Clearing unparsable submission time of %s for homework %s: %v",
+				submission.StudentID, homeworkID, err)
+		} else {
+			submissionTime = &parsed
+		}
+	}
+
+	var file any
+	if len(submission.SubmissionFile) > 0 {
+		file = string(submission.SubmissionFile)
+	}
+
+	if _, err := tx.ExecContext(ctx, `INSERT INTO submissions
+    (homework_id, student_id, owner_id, version, submission_time, submission_file, partners_id)
+SELECT ?0, ?1, ?1, COALESCE(MAX(version), 0) + 1, ?2, ?3::jsonb, ?4
+FROM submissions WHERE homework_id = ?0 AND owner_id = ?1`, homeworkID, submission.StudentID,
+		submissionTime, file, pgdialect.Array(submission.PartnersID)); err != nil {
+		return fmt.Errorf("failed to insert submission: %w", err)
+	}
+
+	return nil
+}
+
+// legacyFile is a file as earlier versions stored it, with its content inline.
+type legacyFile struct {
+	FileRef
+
+	Content []byte `json:"content"`
+}
+
+// inlineFileMigration writes the inline content of legacy files to the blob store. The blob
+// store is only opened once a file needs it.
+type inlineFileMigration struct {
+	blobs BlobStore
+	// keys holds the blobs written for the row being migrated, removed if it fails.
+	keys []string
+}
+
+// store writes the inline content of each file to the blob store and returns the files as
+// references, and whether any file had content.
+func (m *inlineFileMigration) store(ctx context.Context, files []*legacyFile) ([]*FileRef, bool, error) {
+	refs := make([]*FileRef, 0, len(files))
+	stored := false
+
+	for _, file := range files {
+		if len(file.Content) == 0 || file.ContentRef != "" {
+			refs = append(refs, &file.FileRef)
+			continue
+		}
+
+		if m.blobs == nil {
+			blobs, err := InitializeBlobStore(ctx)
+			if err != nil {
+				return nil, false, err
+			}
+
+			m.blobs = blobs
+		}
+
+		ref, err := putBlob(ctx, m.blobs, file.Filename, file.MimeType, bytes.NewReader(file.Content))
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to store %s: %w", file.Filename, err)
+		}
+
+		m.keys = append(m.keys, ref.ContentRef)
+		refs = append(refs, ref)
+		stored = true
+	}
+
+	return refs, stored, nil
+}
+
+// row migrates the files of one row: it stores their content, then runs update with the
+// references in a transaction that also records the stored files for the homework. The
+// blobs are removed again if the transaction fails.
+func (m *inlineFileMigration) row(ctx context.Context, db *bun.DB, homeworkID string, files []*legacyFile,
+	update func(ctx context.Context, tx bun.Tx, refs []*FileRef) error,
+) error {
+	m.keys = nil
+
+	refs, stored, err := m.store(ctx, files)
+	if err == nil && stored {
+		err = db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			for _, ref := range refs {
+				if !slices.Contains(m.keys, ref.ContentRef) {
+					continue
+				}
+
+				if _, err := tx.NewInsert().Model(&StoredFile{
+					ContentRef: ref.ContentRef,
+					HomeworkID: homeworkID,
+					Filename:   ref.Filename,
+					MimeType:   ref.MimeType,
+					SHA256:     ref.SHA256,
+					Size:       ref.Size,
+				}).Exec(ctx); err != nil {
+					return fmt.Errorf("failed to record stored file: %w", err)
+				}
+			}
+
+			return update(ctx, tx, refs)
+		})
+	}
+
+	if err != nil {
+		for _, key := range m.keys {
+			if err := m.blobs.Delete(ctx, key); err != nil {
+				klog.Errorf("Failed to delete blob %s: %v", key, err)
+			}
+		}
+	}
+
+	return err
+}
+
+// migrateInlineFiles moves the content that earlier versions stored inline in the files of
+// homeworks and submissions to the blob store, records the stored files and replaces the
+// files with references to them. Rows are migrated one at a time, so an interrupted run
+// resumes with the files still inline.
+func migrateInlineFiles(ctx context.Context, db *bun.DB) error {
+	migration := &inlineFileMigration{}
+	migrated := 0
+
+	var homeworkIDs, homeworkFiles []string
+
+	// decoding is cheaper than asking PostgreSQL, as files may hold a JSON null.
+	if err := db.NewSelect().Table("homeworks").Column("id").ColumnExpr("files::text").
+		Where("files IS NOT NULL").Scan(ctx, &homeworkIDs, &homeworkFiles); err != nil &&
+		!errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to read homework files: %w", err)
+	}
+
+	for i, value := range homeworkFiles {
+		var files []*legacyFile
+		if err := json.Unmarshal([]byte(value), &files); err != nil {
+			return fmt.Errorf("failed to decode files of homework %s: %w", homeworkIDs[i], err)
+		}
+
+		id := homeworkIDs[i]
+
+		if err := migration.row(ctx, db, id, files, func(ctx context.Context, tx bun.Tx, refs []*FileRef) error {
+			data, err := json.Marshal(refs)
+			if err != nil {
+				return fmt.Errorf("failed to encode files: %w", err)
+			}
+
+			_, err = tx.NewUpdate().Table("homeworks").Set("files = ?::jsonb", string(data)).
+				Where("id = ?", id).Exec(ctx)
+
+			return err
+		}); err != nil {
+			return fmt.Errorf("failed to migrate files of homework %s: %w", id, err)
+		}
+
+		migrated += len(migration.keys)
+	}
+
+	var submissionIDs, submissionHomeworkIDs, submissionFiles []string
+
+	if err := db.NewSelect().Table("submissions").Column("id", "homework_id").
+		ColumnExpr("submission_file::text").Where("submission_file->>'content' IS NOT NULL").
+		Scan(ctx, &submissionIDs, &submissionHomeworkIDs, &submissionFiles); err != nil &&
+		!errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to read submission files: %w", err)
+	}
+
+	for i, value := range submissionFiles {
+		file := new(legacyFile)
+		if err := json.Unmarshal([]byte(value), file); err != nil {
+			return fmt.Errorf("failed to decode file of submission %s: %w", submissionIDs[i], err)
+		}
+
+		id := submissionIDs[i]
+
+		if err := migration.row(ctx, db, submissionHomeworkIDs[i], []*legacyFile{file},
+			func(ctx context.Context, tx bun.Tx, refs []*FileRef) error {
+				data, err := json.Marshal(refs[0])
+				if err != nil {
+					return fmt.Errorf("failed to encode file: %w", err)
+				}
+
+				_, err = tx.NewUpdate().Table("submissions").Set("submission_file = ?::jsonb", string(data)).
+					Where("id = ?", id).Exec(ctx)
+
+				return err
+			}); err != nil {
+			return fmt.Errorf("failed to migrate file of submission %s: %w", id, err)
+		}
+
+		migrated += len(migration.keys)
+	}
+
+	if migrated > 0 {
+		klog.Infof("Moved the content of %d inline files to the blob store.", migrated)
+	}
+
+	return nil
+}
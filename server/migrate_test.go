@@ -0,0 +1,25 @@
+package main
+
+import "testing"
+
+func TestNewMigrations(t *testing.T) {
+	migrations, err := newMigrations()
+	if err != nil {
+		t.Fatalf("newMigrations: %v", err)
+	}
+
+	// the embedded baseline is discovered with both directions.
+	for _, migration := range migrations.Sorted() {
+		if migration.Comment != "baseline" {
+			continue
+		}
+
+		if migration.Up == nil || migration.Down == nil {
+			t.Fatalf("baseline migration %s misses a direction", migration.Name)
+		}
+
+		return
+	}
+
+	t.Fatal("newMigrations found no baseline migration")
+}
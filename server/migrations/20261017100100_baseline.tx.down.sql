@@ -0,0 +1,8 @@
+-- The baseline is never rolled back: reverting it would drop every table, and every record
+-- with it. Drop the database instead to start over.
+
+DO $$
+BEGIN
+    RAISE EXCEPTION 'refusing to roll back the baseline migration; drop the database instead to start over';
+END
+$$;
@@ -0,0 +1,148 @@
+-- Baseline schema. Databases created before migrations already hold the homeworks table,
+-- without the columns added since, so its statements are idempotent and bring it up to date.
+
+CREATE TABLE IF NOT EXISTS homeworks (
+    unique_id VARCHAR NOT NULL DEFAULT gen_random_uuid(),
+    id VARCHAR NOT NULL,
+    course_id VARCHAR NOT NULL,
+    title VARCHAR NOT NULL,
+    description VARCHAR NOT NULL,
+    files jsonb,
+    workflow VARCHAR NOT NULL,
+    due_date TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    late_policy jsonb,
+    max_attempts INTEGER NOT NULL DEFAULT 0,
+    max_group_size INTEGER NOT NULL DEFAULT 0,
+    rubric jsonb,
+    workflow_steps VARCHAR[],
+    PRIMARY KEY (unique_id),
+    UNIQUE (id)
+);
+
+ALTER TABLE homeworks
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    ADD COLUMN IF NOT EXISTS late_policy jsonb,
+    ADD COLUMN IF NOT EXISTS max_attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS max_group_size INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rubric jsonb,
+    ADD COLUMN IF NOT EXISTS workflow_steps VARCHAR[];
+
+-- the first versions stored files as an array column.
+DO $$
+BEGIN
+    IF (SELECT data_type FROM information_schema.columns WHERE table_schema = current_schema()
+            AND table_name = 'homeworks' AND column_name = 'files') = 'ARRAY' THEN
+        ALTER TABLE homeworks ALTER COLUMN files TYPE jsonb USING array_to_json(files)::jsonb;
+    END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS submissions (
+    id VARCHAR NOT NULL DEFAULT gen_random_uuid(),
+    homework_id VARCHAR NOT NULL,
+    student_id VARCHAR NOT NULL,
+    group_id VARCHAR,
+    owner_id VARCHAR NOT NULL,
+    version INTEGER NOT NULL,
+    final BOOLEAN NOT NULL DEFAULT false,
+    submission_time TIMESTAMPTZ,
+    submission_file jsonb,
+    partners_id VARCHAR[],
+    is_late BOOLEAN NOT NULL DEFAULT false,
+    late_by BIGINT NOT NULL DEFAULT 0,
+    penalty_percent DOUBLE PRECISION NOT NULL DEFAULT 0,
+    PRIMARY KEY (id),
+    CONSTRAINT submissions_homework_id_owner_id_version UNIQUE (homework_id, owner_id, version),
+    FOREIGN KEY (homework_id) REFERENCES homeworks (id) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS stored_files (
+    content_ref VARCHAR NOT NULL,
+    homework_id VARCHAR NOT NULL,
+    uploaded_by VARCHAR NOT NULL,
+    filename VARCHAR NOT NULL,
+    mime_type VARCHAR NOT NULL,
+    sha256 VARCHAR NOT NULL,
+    size BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    PRIMARY KEY (content_ref)
+);
+
+CREATE TABLE IF NOT EXISTS extensions (
+    id VARCHAR NOT NULL DEFAULT gen_random_uuid(),
+    homework_id VARCHAR NOT NULL,
+    student_id VARCHAR NOT NULL,
+    due_date TIMESTAMPTZ NOT NULL,
+    reason VARCHAR NOT NULL,
+    granted_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    PRIMARY KEY (id),
+    CONSTRAINT extensions_homework_id_student_id UNIQUE (homework_id, student_id),
+    FOREIGN KEY (homework_id) REFERENCES homeworks (id) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS group_members (
+    id VARCHAR NOT NULL DEFAULT gen_random_uuid(),
+    homework_id VARCHAR NOT NULL,
+    student_id VARCHAR NOT NULL,
+    group_id VARCHAR NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT group_members_homework_id_student_id UNIQUE (homework_id, student_id),
+    FOREIGN KEY (homework_id) REFERENCES homeworks (id) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS grades (
+    id VARCHAR NOT NULL DEFAULT gen_random_uuid(),
+    submission_id VARCHAR NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    max_score DOUBLE PRECISION NOT NULL,
+    feedback VARCHAR NOT NULL,
+    selections jsonb,
+    graded_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE (submission_id),
+    FOREIGN KEY (submission_id) REFERENCES submissions (id) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS regrades (
+    id VARCHAR NOT NULL DEFAULT gen_random_uuid(),
+    submission_id VARCHAR NOT NULL,
+    homework_id VARCHAR NOT NULL,
+    student_id VARCHAR NOT NULL,
+    justification VARCHAR NOT NULL,
+    status VARCHAR NOT NULL,
+    response VARCHAR NOT NULL DEFAULT '',
+    original_score DOUBLE PRECISION NOT NULL,
+    max_score DOUBLE PRECISION NOT NULL,
+    adjusted_score DOUBLE PRECISION,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    resolved_at TIMESTAMPTZ,
+    PRIMARY KEY (id),
+    FOREIGN KEY (submission_id) REFERENCES submissions (id) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS workflow_progress (
+    id VARCHAR NOT NULL DEFAULT gen_random_uuid(),
+    homework_id VARCHAR NOT NULL,
+    student_id VARCHAR NOT NULL,
+    completed jsonb NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+    PRIMARY KEY (id),
+    CONSTRAINT workflow_progress_homework_id_student_id UNIQUE (homework_id, student_id),
+    FOREIGN KEY (homework_id) REFERENCES homeworks (id) ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS homeworks_course_id_due_idx ON homeworks (course_id, (due_date IS NULL), due_date, id);
+CREATE INDEX IF NOT EXISTS homeworks_course_id_created_at_idx ON homeworks (course_id, created_at, id);
+CREATE INDEX IF NOT EXISTS submissions_homework_id_student_id_idx ON submissions (homework_id, student_id);
+CREATE INDEX IF NOT EXISTS submissions_student_id_idx ON submissions (student_id);
+CREATE INDEX IF NOT EXISTS stored_files_homework_id_idx ON stored_files (homework_id);
+CREATE INDEX IF NOT EXISTS group_members_group_id_idx ON group_members (group_id);
+CREATE INDEX IF NOT EXISTS regrades_homework_id_status_idx ON regrades (homework_id, status);
+
+-- at most one version of a student's or group's submissions is final.
+CREATE UNIQUE INDEX IF NOT EXISTS submissions_final_idx ON submissions (homework_id, owner_id) WHERE final;
+
+-- a submission has at most one open regrade request.
+CREATE UNIQUE INDEX IF NOT EXISTS regrades_open_idx ON regrades (submission_id)
+    WHERE status = 'REGRADE_STATUS_OPEN';
@@ -0,0 +1 @@
+ALTER TABLE homeworks DROP COLUMN version;
@@ -0,0 +1,2 @@
+-- homeworks are versioned so concurrent updates cannot overwrite each other.
+ALTER TABLE homeworks ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
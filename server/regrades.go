@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"slices"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// RequestRegrade opens a regrade request on a graded submission.
+func (s *HomeworkServer) RequestRegrade(ctx context.Context,
+	req *hpb.RequestRegradeRequest,
+) (*hpb.RequestRegradeResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received RequestRegrade request", "submissionId", req.GetSubmissionId(),
+		"studentId", req.GetStudentId())
+
+	if req.GetSubmissionId() == "" {
+		return nil, invalidArgument("submissionId", "submissionId is empty")
+	}
+
+	if req.GetStudentId() == "" {
+		return nil, invalidArgument("studentId", "studentId is empty")
+	}
+
+	if req.GetJustification() == "" {
+		return nil, invalidArgument("justification", "justification is empty")
+	}
+
+	submission, _, err := s.authorizeSubmission(ctx, req.GetSubmissionId(),
+		func(courseID string, _ *hpb.Submission) bool { return caller.actsFor(courseID, req.GetStudentId()) })
+	if err != nil {
+		return nil, err
+	}
+
+	// only the submitter and the other members of their group may dispute the grade.
+	members := append([]string{submission.GetStudentId()}, submission.GetPartnersId()...)
+	if !slices.Contains(members, req.GetStudentId()) {
+		return nil, status.Errorf(codes.PermissionDenied, "student %s did not make submission %s",
+			req.GetStudentId(), req.GetSubmissionId())
+	}
+
+	regrade, err := s.db.AddRegrade(ctx, &hpb.Regrade{
+		SubmissionId:  req.GetSubmissionId(),
+		StudentId:     req.GetStudentId(),
+		Justification: req.GetJustification(),
+	})
+	if err != nil {
+		logger.Error(err, "failed to request regrade", "submissionId", req.GetSubmissionId())
+
+		return nil, statusError(err, "failed to request regrade of submission %s", req.GetSubmissionId())
+	}
+
+	logger.V(logLevelDebug).Info("Successfully requested regrade", "id", regrade.GetId(),
+		"submissionId", req.GetSubmissionId())
+
+	return &hpb.RequestRegradeResponse{Regrade: regrade}, nil
+}
+
+// ResolveRegrade accepts or rejects an open regrade request.
+func (s *HomeworkServer) ResolveRegrade(ctx context.Context,
+	req *hpb.ResolveRegradeRequest,
+) (*hpb.ResolveRegradeResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received ResolveRegrade request", "regradeId", req.GetRegradeId(),
+		"status", req.GetStatus())
+
+	if req.GetRegradeId() == "" {
+		return nil, invalidArgument("regradeId", "regradeId is empty")
+	}
+
+	switch req.GetStatus() {
+	case hpb.RegradeStatus_REGRADE_STATUS_ACCEPTED:
+		if req.AdjustedScore == nil {
+			return nil, invalidArgument("adjustedScore", "adjustedScore is required when accepting")
+		}
+	case hpb.RegradeStatus_REGRADE_STATUS_REJECTED:
+		if req.AdjustedScore != nil {
+			return nil, invalidArgument("adjustedScore", "adjustedScore must be unset when rejecting")
+		}
+	default:
+		return nil, invalidArgument("status", "status must be accepted or rejected")
+	}
+
+	regrade, err := s.db.GetRegrade(ctx, req.GetRegradeId())
+	if err != nil {
+		logger.Error(err, "failed to get regrade request", "regradeId", req.GetRegradeId())
+
+		return nil, statusError(err, "failed to get regrade request %s", req.GetRegradeId())
+	}
+
+	if _, err := s.authorizeHomework(ctx, regrade.GetHomeworkId(), caller.isStaff); err != nil {
+		return nil, err
+	}
+
+	if req.AdjustedScore != nil && (req.GetAdjustedScore() < 0 || req.GetAdjustedScore() > regrade.GetMaxScore()) {
+		return nil, invalidArgument("adjustedScore", "adjustedScore must be between 0 and %v",
+			regrade.GetMaxScore())
+	}
+
+	regrade, grade, err := s.db.ResolveRegrade(ctx, &hpb.Regrade{
+		Id:            req.GetRegradeId(),
+		Status:        req.GetStatus(),
+		Response:      req.GetResponse(),
+		AdjustedScore: req.AdjustedScore,
+	})
+	if err != nil {
+		logger.Error(err, "failed to resolve regrade request", "regradeId", req.GetRegradeId())
+
+		return nil, statusError(err, "failed to resolve regrade request %s", req.GetRegradeId())
+	}
+
+	logger.V(logLevelDebug).Info("Successfully resolved regrade request", "regradeId", req.GetRegradeId(),
+		"status", regrade.GetStatus())
+
+	return &hpb.ResolveRegradeResponse{Regrade: regrade, Grade: grade}, nil
+}
+
+// ListOpenRegrades lists the open regrade requests of a course.
+func (s *HomeworkServer) ListOpenRegrades(ctx context.Context,
+	req *hpb.ListOpenRegradesRequest,
+) (*hpb.ListOpenRegradesResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received ListOpenRegrades request", "courseId", req.GetCourseId())
+
+	if req.GetCourseId() == "" {
+		return nil, invalidArgument("courseId", "courseId is empty")
+	}
+
+	if !caller.isStaff(req.GetCourseId()) {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to list the regrade requests of course %s",
+			req.GetCourseId())
+	}
+
+	// get the open regrade requests from the database.
+	regrades, err := s.db.ListOpenRegrades(ctx, req.GetCourseId())
+	if err != nil {
+		logger.Error(err, "failed to list regrade requests", "courseId", req.GetCourseId())
+		return nil, statusError(err, "failed to list regrade requests")
+	}
+
+	logger.V(logLevelDebug).Info("Successfully listed regrade requests", "courseId", req.GetCourseId(),
+		"count", len(regrades))
+
+	return &hpb.ListOpenRegradesResponse{Regrades: regrades}, nil
+}
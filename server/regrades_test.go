@@ -0,0 +1,236 @@
+package main
+
+import (
+	"testing"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRegradeRequestValidation(t *testing.T) {
+	// every request below is rejected before the database is reached.
+	server, ctx := newValidationServer(t)
+
+	resolve := func(req *hpb.ResolveRegradeRequest) func() error {
+		return func() error {
+			_, err := server.ResolveRegrade(ctx, req)
+
+			return err
+		}
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{"request without a student", func() error {
+			_, err := server.RequestRegrade(ctx, &hpb.RequestRegradeRequest{
+				SubmissionId: "s", Justification: "why",
+			})
+			return err
+		}, codes.InvalidArgument},
+		{"request without a justification", func() error {
+			_, err := server.RequestRegrade(ctx, &hpb.RequestRegradeRequest{
+				SubmissionId: "s", StudentId: "student-1",
+			})
+			return err
+		}, codes.InvalidArgument},
+		{"resolve no regrade", resolve(&hpb.ResolveRegradeRequest{
+			Status: hpb.RegradeStatus_REGRADE_STATUS_REJECTED,
+		}), codes.InvalidArgument},
+		{"accept without a score", resolve(&hpb.ResolveRegradeRequest{
+			RegradeId: "r", Status: hpb.RegradeStatus_REGRADE_STATUS_ACCEPTED,
+		}), codes.InvalidArgument},
+		{"reject with a score", resolve(&hpb.ResolveRegradeRequest{
+			RegradeId: "r", Status: hpb.RegradeStatus_REGRADE_STATUS_REJECTED, AdjustedScore: proto.Float64(1),
+		}), codes.InvalidArgument},
+		{"resolve back to open", resolve(&hpb.ResolveRegradeRequest{
+			RegradeId: "r", Status: hpb.RegradeStatus_REGRADE_STATUS_OPEN,
+		}), codes.InvalidArgument},
+		{"list without a course", func() error {
+			_, err := server.ListOpenRegrades(ctx, &hpb.ListOpenRegradesRequest{})
+			return err
+		}, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantCode(t, tt.call(), tt.want)
+		})
+	}
+}
+
+func TestRegradeLifecycle(t *testing.T) {
+	course := newCourseTest(t, newTestHomework("hw-1"))
+	client, staff, student := course.client, course.staff, course.student
+
+	submitted, err := client.SubmitHomework(student, &hpb.SubmitHomeworkRequest{
+		Id:         "hw-1",
+		Submission: &hpb.Submission{StudentId: "student-1"},
+	})
+	if err != nil {
+		t.Fatalf("SubmitHomework: %v", err)
+	}
+
+	submissionID := submitted.GetSubmission().GetId()
+	request := &hpb.RequestRegradeRequest{
+		SubmissionId:  submissionID,
+		StudentId:     "student-1",
+		Justification: "The tests pass.",
+	}
+
+	_, err = client.RequestRegrade(student, request)
+	wantCode(t, err, codes.FailedPrecondition)
+
+	if _, err := client.SetGrade(staff, &hpb.SetGradeRequest{
+		SubmissionId: submissionID,
+		Score:        6,
+		MaxScore:     10,
+	}); err != nil {
+		t.Fatalf("SetGrade: %v", err)
+	}
+
+	_, err = client.RequestRegrade(course.asStudent(t, "student-2"), &hpb.RequestRegradeRequest{
+		SubmissionId:  submissionID,
+		StudentId:     "student-2",
+		Justification: "The tests pass.",
+	})
+	wantCode(t, err, codes.PermissionDenied)
+
+	requested, err := client.RequestRegrade(student, request)
+	if err != nil {
+		t.Fatalf("RequestRegrade: %v", err)
+	}
+
+	regrade := requested.GetRegrade()
+	if regrade.GetStatus() != hpb.RegradeStatus_REGRADE_STATUS_OPEN || regrade.GetOriginalScore() != 6 ||
+		regrade.GetMaxScore() != 10 || regrade.GetHomeworkId() != "hw-1" {
+		t.Fatalf("RequestRegrade returned %v", regrade)
+	}
+
+	_, err = client.RequestRegrade(student, request)
+	wantCode(t, err, codes.AlreadyExists)
+
+	open, err := client.ListOpenRegrades(staff, &hpb.ListOpenRegradesRequest{CourseId: testCourse})
+	if err != nil {
+		t.Fatalf("ListOpenRegrades: %v", err)
+	}
+
+	if len(open.GetRegrades()) != 1 || open.GetRegrades()[0].GetId() != regrade.GetId() {
+		t.Fatalf("ListOpenRegrades returned %v", open.GetRegrades())
+	}
+
+	_, err = client.ListOpenRegrades(student, &hpb.ListOpenRegradesRequest{CourseId: testCourse})
+	wantCode(t, err, codes.PermissionDenied)
+
+	_, err = client.ResolveRegrade(staff, &hpb.ResolveRegradeRequest{
+		RegradeId:     regrade.GetId(),
+		Status:        hpb.RegradeStatus_REGRADE_STATUS_ACCEPTED,
+		AdjustedScore: proto.Float64(11),
+	})
+	wantCode(t, err, codes.InvalidArgument)
+
+	_, err = client.ResolveRegrade(student, &hpb.ResolveRegradeRequest{
+		RegradeId: regrade.GetId(),
+		Status:    hpb.RegradeStatus_REGRADE_STATUS_REJECTED,
+	})
+	wantCode(t, err, codes.PermissionDenied)
+
+	rejected, err := client.ResolveRegrade(staff, &hpb.ResolveRegradeRequest{
+		RegradeId: regrade.GetId(),
+		Status:    hpb.RegradeStatus_REGRADE_STATUS_REJECTED,
+		Response:  "Two tests fail.",
+	})
+	if err != nil {
+		t.Fatalf("ResolveRegrade: %v", err)
+	}
+
+	if rejected.GetRegrade().GetResolvedAt() == nil || rejected.GetRegrade().GetResponse() != "Two tests fail." ||
+		rejected.GetGrade() != nil {
+		t.Fatalf("ResolveRegrade returned %v with grade %v", rejected.GetRegrade(), rejected.GetGrade())
+	}
+
+	_, err = client.ResolveRegrade(staff, &hpb.ResolveRegradeRequest{
+		RegradeId: regrade.GetId(),
+		Status:    hpb.RegradeStatus_REGRADE_STATUS_REJECTED,
+	})
+	wantCode(t, err, codes.FailedPrecondition)
+
+	// a resolved request makes room for another one.
+	reopened, err := client.RequestRegrade(student, request)
+	if err != nil {
+		t.Fatalf("RequestRegrade: %v", err)
+	}
+
+	if _, err := client.ResolveRegrade(staff, &hpb.ResolveRegradeRequest{
+		RegradeId:     reopened.GetRegrade().GetId(),
+		Status:        hpb.RegradeStatus_REGRADE_STATUS_ACCEPTED,
+		AdjustedScore: proto.Float64(9),
+	}); err != nil {
+		t.Fatalf("ResolveRegrade: %v", err)
+	}
+
+	grades, err := client.GetGrades(staff, &hpb.GetGradesRequest{HomeworkId: "hw-1"})
+	if err != nil {
+		t.Fatalf("GetGrades: %v", err)
+	}
+
+	if len(grades.GetGrades()) != 1 || grades.GetGrades()[0].GetScore() != 9 {
+		t.Fatalf("GetGrades returned %v after the accepted regrade, want score 9", grades.GetGrades())
+	}
+
+	open, err = client.ListOpenRegrades(staff, &hpb.ListOpenRegradesRequest{CourseId: testCourse})
+	if err != nil {
+		t.Fatalf("ListOpenRegrades: %v", err)
+	}
+
+	if len(open.GetRegrades()) != 0 {
+		t.Fatalf("ListOpenRegrades returned resolved requests %v", open.GetRegrades())
+	}
+}
+
+func TestAcceptedRegradeClearsRubricSelections(t *testing.T) {
+	course := newCourseTest(t, newRubricHomework("hw-1"))
+	client, staff, student := course.client, course.staff, course.student
+
+	submitted, err := client.SubmitHomework(student, &hpb.SubmitHomeworkRequest{
+		Id:         "hw-1",
+		Submission: &hpb.Submission{StudentId: "student-1"},
+	})
+	if err != nil {
+		t.Fatalf("SubmitHomework: %v", err)
+	}
+
+	submissionID := submitted.GetSubmission().GetId()
+
+	if _, err := client.SetGrade(staff, &hpb.SetGradeRequest{
+		SubmissionId: submissionID,
+		Selections:   []*hpb.CriterionSelection{{CriterionId: "correctness", LevelId: "partial"}},
+	}); err != nil {
+		t.Fatalf("SetGrade: %v", err)
+	}
+
+	requested, err := client.RequestRegrade(student, &hpb.RequestRegradeRequest{
+		SubmissionId:  submissionID,
+		StudentId:     "student-1",
+		Justification: "The implementation is complete.",
+	})
+	if err != nil {
+		t.Fatalf("RequestRegrade: %v", err)
+	}
+
+	resolved, err := client.ResolveRegrade(staff, &hpb.ResolveRegradeRequest{
+		RegradeId:     requested.GetRegrade().GetId(),
+		Status:        hpb.RegradeStatus_REGRADE_STATUS_ACCEPTED,
+		AdjustedScore: proto.Float64(8),
+	})
+	if err != nil {
+		t.Fatalf("ResolveRegrade: %v", err)
+	}
+
+	if grade := resolved.GetGrade(); grade.GetScore() != 8 || len(grade.GetSelections()) != 0 {
+		t.Fatalf("ResolveRegrade left grade %v, want score 8 without selections", grade)
+	}
+}
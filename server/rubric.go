@@ -0,0 +1,116 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+)
+
+// validateRubric checks that a rubric's criteria and levels are identified uniquely and
+// that every criterion has at least one level with non-negative points.
+func validateRubric(rubric *hpb.Rubric) error {
+	if rubric == nil {
+		return nil
+	}
+
+	if len(rubric.GetCriteria()) == 0 {
+		return errors.New("a rubric needs at least one criterion")
+	}
+
+	criteria := make(map[string]bool, len(rubric.GetCriteria()))
+
+	for _, criterion := range rubric.GetCriteria() {
+		switch {
+		case criterion.GetId() == "":
+			return errors.New("criterion IDs must not be empty")
+		case criteria[criterion.GetId()]:
+			return fmt.Errorf("criterion %s is defined more than once", criterion.GetId())
+		case len(criterion.GetLevels()) == 0:
+			return fmt.Errorf("criterion %s needs at least one level", criterion.GetId())
+		}
+
+		criteria[criterion.GetId()] = true
+		levels := make(map[string]bool, len(criterion.GetLevels()))
+
+		for _, level := range criterion.GetLevels() {
+			switch {
+			case level.GetId() == "":
+				return fmt.Errorf("level IDs of criterion %s must not be empty", criterion.GetId())
+			case levels[level.GetId()]:
+				return fmt.Errorf("level %s of criterion %s is defined more than once",
+					level.GetId(), criterion.GetId())
+			case level.GetPoints() < 0:
+				return fmt.Errorf("level %s of criterion %s has negative points", level.GetId(), criterion.GetId())
+			}
+
+			levels[level.GetId()] = true
+		}
+	}
+
+	return nil
+}
+
+// rubricScore is the outcome of grading with a rubric.
+type rubricScore struct {
+	score    float64
+	maxScore float64
+	// selections are the grader's selections in rubric order, with their points filled in.
+	selections []*hpb.CriterionSelection
+}
+
+// scoreRubric computes the score of a rubric grading. Exactly one level must be
+// selected for every criterion of the rubric.
+func scoreRubric(rubric *hpb.Rubric, selections []*hpb.CriterionSelection) (rubricScore, error) {
+	selected := make(map[string]*hpb.CriterionSelection, len(selections))
+
+	for _, selection := range selections {
+		if selected[selection.GetCriterionId()] != nil {
+			return rubricScore{}, fmt.Errorf("criterion %s is selected more than once", selection.GetCriterionId())
+		}
+
+		selected[selection.GetCriterionId()] = selection
+	}
+
+	result := rubricScore{selections: make([]*hpb.CriterionSelection, 0, len(rubric.GetCriteria()))}
+
+	for _, criterion := range rubric.GetCriteria() {
+		selection := selected[criterion.GetId()]
+		if selection == nil {
+			return rubricScore{}, fmt.Errorf("criterion %s has no selection", criterion.GetId())
+		}
+
+		delete(selected, criterion.GetId())
+
+		var level *hpb.RubricLevel
+
+		best := 0.0
+
+		for _, l := range criterion.GetLevels() {
+			best = max(best, l.GetPoints())
+
+			if l.GetId() == selection.GetLevelId() {
+				level = l
+			}
+		}
+
+		if level == nil {
+			return rubricScore{}, fmt.Errorf("criterion %s has no level %s", criterion.GetId(), selection.GetLevelId())
+		}
+
+		result.score += level.GetPoints()
+		result.maxScore += best
+		result.selections = append(result.selections, &hpb.CriterionSelection{
+			CriterionId: criterion.GetId(),
+			LevelId:     level.GetId(),
+			Comment:     selection.GetComment(),
+			Points:      level.GetPoints(),
+		})
+	}
+
+	for id := range selected {
+		return rubricScore{}, fmt.Errorf("the rubric has no criterion %s", id)
+	}
+
+	return result, nil
+}
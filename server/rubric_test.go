@@ -0,0 +1,176 @@
+package main
+
+import (
+	"testing"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// testRubric has two criteria worth up to 10 and 5 points.
+func testRubric() *hpb.Rubric {
+	return &hpb.Rubric{Criteria: []*hpb.RubricCriterion{
+		{Id: "correctness", Levels: []*hpb.RubricLevel{{Id: "full", Points: 10}, {Id: "partial", Points: 4}}},
+		{Id: "style", Levels: []*hpb.RubricLevel{{Id: "good", Points: 5}, {Id: "poor", Points: 0}}},
+	}}
+}
+
+func TestValidateRubric(t *testing.T) {
+	for _, rubric := range []*hpb.Rubric{nil, testRubric()} {
+		if err := validateRubric(rubric); err != nil {
+			t.Errorf("validateRubric(%v) = %v", rubric, err)
+		}
+	}
+
+	invalid := []*hpb.Rubric{
+		{},
+		{Criteria: []*hpb.RubricCriterion{{Levels: []*hpb.RubricLevel{{Id: "a"}}}}},
+		{Criteria: []*hpb.RubricCriterion{{Id: "c"}}},
+		{Criteria: []*hpb.RubricCriterion{
+			{Id: "c", Levels: []*hpb.RubricLevel{{Id: "a"}}},
+			{Id: "c", Levels: []*hpb.RubricLevel{{Id: "a"}}},
+		}},
+		{Criteria: []*hpb.RubricCriterion{{Id: "c", Levels: []*hpb.RubricLevel{{}}}}},
+		{Criteria: []*hpb.RubricCriterion{{Id: "c", Levels: []*hpb.RubricLevel{{Id: "a"}, {Id: "a"}}}}},
+		{Criteria: []*hpb.RubricCriterion{{Id: "c", Levels: []*hpb.RubricLevel{{Id: "a", Points: -1}}}}},
+	}
+
+	for _, rubric := range invalid {
+		if err := validateRubric(rubric); err == nil {
+			t.Errorf("validateRubric accepted %v", rubric)
+		}
+	}
+}
+
+func TestScoreRubric(t *testing.T) {
+	scored, err := scoreRubric(testRubric(), []*hpb.CriterionSelection{
+		{CriterionId: "style", LevelId: "good"},
+		{CriterionId: "correctness", LevelId: "partial", Comment: "misses edge cases", Points: 100},
+	})
+	if err != nil {
+		t.Fatalf("scoreRubric: %v", err)
+	}
+
+	// selections come back in rubric order with the points of their level.
+	want := []*hpb.CriterionSelection{
+		{CriterionId: "correctness", LevelId: "partial", Comment: "misses edge cases", Points: 4},
+		{CriterionId: "style", LevelId: "good", Points: 5},
+	}
+
+	if scored.score != 9 || scored.maxScore != 15 || len(scored.selections) != len(want) {
+		t.Fatalf("scoreRubric = %v of %v with %v", scored.score, scored.maxScore, scored.selections)
+	}
+
+	for i := range want {
+		if !proto.Equal(scored.selections[i], want[i]) {
+			t.Errorf("selection %d = %v, want %v", i, scored.selections[i], want[i])
+		}
+	}
+
+	invalid := map[string][]*hpb.CriterionSelection{
+		"missing criterion": {{CriterionId: "correctness", LevelId: "full"}},
+		"unknown level": {
+			{CriterionId: "correctness", LevelId: "perfect"}, {CriterionId: "style", LevelId: "good"},
+		},
+		"unknown criterion": {
+			{CriterionId: "correctness", LevelId: "full"}, {CriterionId: "style", LevelId: "good"},
+			{CriterionId: "speed", LevelId: "fast"},
+		},
+		"selected twice": {
+			{CriterionId: "correctness", LevelId: "full"}, {CriterionId: "correctness", LevelId: "partial"},
+			{CriterionId: "style", LevelId: "good"},
+		},
+	}
+
+	for name, selections := range invalid {
+		if _, err := scoreRubric(testRubric(), selections); err == nil {
+			t.Errorf("scoreRubric accepted a selection with a %s", name)
+		}
+	}
+}
+
+// newRubricHomework returns a test homework graded by a rubric of one criterion worth up to 10 points.
+func newRubricHomework(id string) *hpb.Homework {
+	homework := newTestHomework(id)
+	homework.Rubric = &hpb.Rubric{Criteria: []*hpb.RubricCriterion{{
+		Id:    "correctness",
+		Title: "Correctness",
+		Levels: []*hpb.RubricLevel{
+			{Id: "partial", Title: "Partial", Points: 5},
+			{Id: "full", Title: "Full", Points: 10},
+		},
+	}}}
+
+	return homework
+}
+
+func TestRubricScoring(t *testing.T) {
+	homework := newRubricHomework("hw-1")
+	homework.Rubric.Criteria = append(homework.Rubric.Criteria, &hpb.RubricCriterion{
+		Id:     "style",
+		Title:  "Style",
+		Levels: []*hpb.RubricLevel{{Id: "poor", Points: 0}, {Id: "good", Points: 4}},
+	})
+
+	course := newCourseTest(t, homework)
+	client, staff := course.client, course.staff
+
+	submitted, err := client.SubmitHomework(course.student, &hpb.SubmitHomeworkRequest{
+		Id:         "hw-1",
+		Submission: &hpb.Submission{StudentId: "student-1"},
+	})
+	if err != nil {
+		t.Fatalf("SubmitHomework: %v", err)
+	}
+
+	submissionID := submitted.GetSubmission().GetId()
+
+	graded, err := client.SetGrade(staff, &hpb.SetGradeRequest{
+		SubmissionId: submissionID,
+		Selections: []*hpb.CriterionSelection{
+			{CriterionId: "style", LevelId: "good", Comment: "Readable."},
+			{CriterionId: "correctness", LevelId: "partial", Points: 100},
+		},
+	})
+	if err != nil {
+		t.Fatalf("SetGrade: %v", err)
+	}
+
+	// the score is the sum of the selected levels out of the best levels, whatever points were sent.
+	grade := graded.GetGrade()
+	if grade.GetScore() != 9 || grade.GetMaxScore() != 14 || len(grade.GetSelections()) != 2 {
+		t.Fatalf("SetGrade returned %v, want 9 of 14", grade)
+	}
+
+	points := map[string]float64{"correctness": 5, "style": 4}
+
+	for _, selection := range grade.GetSelections() {
+		if want := points[selection.GetCriterionId()]; selection.GetPoints() != want {
+			t.Fatalf("selection %v has %v points, want %v", selection.GetCriterionId(), selection.GetPoints(), want)
+		}
+	}
+
+	for name, req := range map[string]*hpb.SetGradeRequest{
+		"missing criterion": {SubmissionId: submissionID, Selections: []*hpb.CriterionSelection{
+			{CriterionId: "correctness", LevelId: "full"},
+		}},
+		"unknown level": {SubmissionId: submissionID, Selections: []*hpb.CriterionSelection{
+			{CriterionId: "correctness", LevelId: "perfect"}, {CriterionId: "style", LevelId: "good"},
+		}},
+		"unknown criterion": {SubmissionId: submissionID, Selections: []*hpb.CriterionSelection{
+			{CriterionId: "correctness", LevelId: "full"}, {CriterionId: "style", LevelId: "good"},
+			{CriterionId: "speed", LevelId: "fast"},
+		}},
+		"selected twice": {SubmissionId: submissionID, Selections: []*hpb.CriterionSelection{
+			{CriterionId: "correctness", LevelId: "full"}, {CriterionId: "correctness", LevelId: "partial"},
+			{CriterionId: "style", LevelId: "good"},
+		}},
+		"plain score": {SubmissionId: submissionID, Score: 10, MaxScore: 14},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.SetGrade(staff, req)
+			wantCode(t, err, codes.InvalidArgument)
+		})
+	}
+}
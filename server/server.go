@@ -2,10 +2,20 @@ package main
 
 import (
 	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"net"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
 	hpb "github.com/BetterGR/homework-microservice/protos"
 	ms "github.com/TekClinic/MicroService-Lib"
@@ -13,6 +23,11 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/klog/v2"
 )
 
@@ -21,11 +36,23 @@ const (
 	connectionProtocol = "tcp"
 	// Debugging logs.
 	logLevelDebug = 5
+	// size of the chunks sent by DownloadFile.
+	downloadChunkSize = 64 * 1024
+	// page sizes of ListHomeworks.
+	defaultPageSize = 50
+	maxPageSize     = 200
 )
 
 type HomeworkServer struct {
 	ms.BaseServiceServer
-	db *Database
+	// db holds homeworks and everything recorded against them.
+	db Storage
+	// blobs holds the contents of homework and submission files.
+	blobs BlobStore
+	// now is the clock used to stamp submissions.
+	now func() time.Time
+	// subjectClaim is the token claim identifying the caller.
+	subjectClaim string
 	// throws unimplemented error
 	hpb.UnimplementedHomeworkServiceServer
 }
@@ -37,25 +64,184 @@ func initHomeworkMicroserviceServer() (*HomeworkServer, error) {
 		return nil, fmt.Errorf("failed to create base service: %w", err)
 	}
 
-	database, err := InitializeDatabase()
+	storage, err := InitializeStorage()
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize database: %w", err)
+		return nil, fmt.Errorf("failed to initialize storage: %w", err)
+	}
+
+	blobs, err := InitializeBlobStore(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize blob store: %w", err)
 	}
 
 	return &HomeworkServer{
 		BaseServiceServer:                  base,
-		db:                                 database,
+		db:                                 storage,
+		blobs:                              blobs,
+		now:                                time.Now,
+		subjectClaim:                       ms.GetOptionalEnv("AUTH_SUBJECT_CLAIM", defaultSubjectClaim),
 		UnimplementedHomeworkServiceServer: hpb.UnimplementedHomeworkServiceServer{},
 	}, nil
 }
 
+// pageToken is the decoded form of a ListHomeworks page token. It records a digest
+// of the query it was issued for, so it cannot be replayed with other filters.
+type pageToken struct {
+	Query  string          `json:"q"`
+	Cursor *HomeworkCursor `json:"c"`
+}
+
+// listQueryDigest returns a digest of the filters and ordering of a ListHomeworks request.
+func listQueryDigest(req *hpb.ListHomeworksRequest) (string, error) {
+	query, ok := proto.Clone(req).(*hpb.ListHomeworksRequest)
+	if !ok {
+		return "", errors.New("unexpected request type")
+	}
+
+	query.Token, query.PageSize, query.PageToken = "", 0, ""
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(query)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal query: %w", err)
+	}
+
+	digest := sha256.Sum256(data)
+
+	return hex.EncodeToString(digest[:8]), nil
+}
+
+// encodePageToken encodes the cursor of the next page of the given query.
+func encodePageToken(query string, cursor *HomeworkCursor) (string, error) {
+	data, err := json.Marshal(pageToken{Query: query, Cursor: cursor})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode page token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodePageToken decodes a page token and checks that it was issued for the given query.
+func decodePageToken(token, query string) (*HomeworkCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("malformed page token: %w", err)
+	}
+
+	var decoded pageToken
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("malformed page token: %w", err)
+	}
+
+	if decoded.Query != query || decoded.Cursor == nil {
+		return nil, errors.New("page token does not match the request")
+	}
+
+	return decoded.Cursor, nil
+}
+
+// validateHomework checks the fields of a homework sent for creation or update and fills in
+// both forms of its due date.
+func validateHomework(homework *hpb.Homework) error {
+	if err := normalizeDueDate(homework); err != nil {
+		return invalidArgument("homework.dueDate", "%v", err)
+	}
+
+	if err := validateLatePolicy(homework.GetLatePolicy()); err != nil {
+		return invalidArgument("homework.latePolicy", "invalid latePolicy: %v", err)
+	}
+
+	if homework.GetMaxAttempts() < 0 {
+		return invalidArgument("homework.maxAttempts", "maxAttempts must not be negative")
+	}
+
+	if homework.GetMaxGroupSize() < 0 {
+		return invalidArgument("homework.maxGroupSize", "maxGroupSize must not be negative")
+	}
+
+	if err := validateRubric(homework.GetRubric()); err != nil {
+		return invalidArgument("homework.rubric", "invalid rubric: %v", err)
+	}
+
+	if err := validateWorkflow(homework.GetWorkflowDefinition()); err != nil {
+		return invalidArgument("homework.workflowDefinition", "invalid workflowDefinition: %v", err)
+	}
+
+	return nil
+}
+
+// maskableHomeworkField is a homework field UpdateHomework may be limited to: the message
+// fields it replaces and the columns storing them.
+type maskableHomeworkField struct {
+	fields  []protoreflect.Name
+	columns []string
+}
+
+// maskableHomeworkFields maps the paths accepted in an update mask. Both forms of the due
+// date replace each other.
+var maskableHomeworkFields = map[string]maskableHomeworkField{
+	"courseId":           {[]protoreflect.Name{"courseId"}, []string{"course_id"}},
+	"title":              {[]protoreflect.Name{"title"}, []string{"title"}},
+	"description":        {[]protoreflect.Name{"description"}, []string{"description"}},
+	"files":              {[]protoreflect.Name{"files"}, []string{"files"}},
+	"workflow":           {[]protoreflect.Name{"workflow"}, []string{"workflow"}},
+	"dueDate":            {[]protoreflect.Name{"dueDate", "dueDateText"}, []string{"due_date"}},
+	"dueDateText":        {[]protoreflect.Name{"dueDate", "dueDateText"}, []string{"due_date"}},
+	"latePolicy":         {[]protoreflect.Name{"latePolicy"}, []string{"late_policy"}},
+	"maxAttempts":        {[]protoreflect.Name{"maxAttempts"}, []string{"max_attempts"}},
+	"maxGroupSize":       {[]protoreflect.Name{"maxGroupSize"}, []string{"max_group_size"}},
+	"rubric":             {[]protoreflect.Name{"rubric"}, []string{"rubric"}},
+	"workflowDefinition": {[]protoreflect.Name{"workflowDefinition"}, []string{"workflow_steps"}},
+}
+
+// applyUpdateMask merges the masked fields of an update into the stored homework and returns
+// the result with the columns to write. An empty mask keeps the whole update and returns nil
+// columns, meaning all of them.
+func applyUpdateMask(existing, update *hpb.Homework, mask *fieldmaskpb.FieldMask) (*hpb.Homework, []string, error) {
+	if len(mask.GetPaths()) == 0 {
+		return update, nil, nil
+	}
+
+	merged, ok := proto.Clone(existing).(*hpb.Homework)
+	if !ok {
+		return nil, nil, status.Error(codes.Internal, "unexpected homework type")
+	}
+
+	merged.Version = update.GetVersion()
+	src, dst := update.ProtoReflect(), merged.ProtoReflect()
+	fields := dst.Descriptor().Fields()
+
+	var columns []string
+
+	for _, path := range mask.GetPaths() {
+		maskable, ok := maskableHomeworkFields[path]
+		if !ok {
+			return nil, nil, invalidArgument("updateMask", "%q cannot be updated", path)
+		}
+
+		for _, name := range maskable.fields {
+			field := fields.ByName(name)
+			if src.Has(field) {
+				dst.Set(field, src.Get(field))
+			} else {
+				dst.Clear(field)
+			}
+		}
+
+		columns = append(columns, maskable.columns...)
+	}
+
+	slices.Sort(columns)
+
+	return merged, slices.Compact(columns), nil
+}
+
 // CreateHomework creates a new homework.
 func (s *HomeworkServer) CreateHomework(ctx context.Context,
 	req *hpb.CreateHomeworkRequest,
 ) (*hpb.CreateHomeworkResponse, error) {
-	if _, err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	logger := klog.FromContext(ctx)
@@ -64,14 +250,31 @@ func (s *HomeworkServer) CreateHomework(ctx context.Context,
 
 	homework := req.GetHomework()
 	if homework == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "homework is nil")
+		return nil, invalidArgument("homework", "homework is nil")
 	}
 
+	if !caller.isStaff(homework.GetCourseId()) {
+		return nil, status.Errorf(codes.PermissionDenied, "only staff may create homeworks of course %s",
+			homework.GetCourseId())
+	}
+
+	if err := validateHomework(homework); err != nil {
+		return nil, err
+	}
+
+	// move the file contents to the blob store.
+	files, err := s.storeFiles(ctx, caller, homework, homework.GetFiles())
+	if err != nil {
+		return nil, err
+	}
+
+	homework.Files = files
+
 	// insert the homework into the database.
 
 	if err := s.db.AddHomework(ctx, homework); err != nil {
 		logger.Error(err, "failed to insert homework")
-		return nil, status.Errorf(codes.Internal, "failed to insert homework: %v", err)
+		return nil, statusError(err, "failed to insert homework")
 	}
 
 	logger.V(logLevelDebug).Info("Successfully created homework", "id", req.GetHomework().GetId())
@@ -83,19 +286,39 @@ func (s *HomeworkServer) CreateHomework(ctx context.Context,
 func (s *HomeworkServer) GetHomework(ctx context.Context,
 	req *hpb.GetHomeworkRequest,
 ) (*hpb.GetHomeworkResponse, error) {
-	if _, err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	logger := klog.FromContext(ctx)
 	logger.V(logLevelDebug).Info("Received GetHomework request", "id", req.GetId())
 
 	// get the homework from the database.
-	homework, err := s.db.GetHomework(ctx, req.GetId())
+	homework, err := s.authorizeHomework(ctx, req.GetId(), caller.isMember)
 	if err != nil {
-		logger.Error(err, "failed to get homework", "id", req.GetId())
-		return nil, status.Errorf(codes.NotFound, "failed to get homework: %v", err)
+		return nil, err
+	}
+
+	// students only see their own and their group's submissions.
+	homework.Submissions, err = s.db.GetSubmissions(ctx, req.GetId(),
+		submissionFilter(caller, homework.GetCourseId()))
+	if err != nil {
+		logger.Error(err, "failed to get submissions", "id", req.GetId())
+		return nil, statusError(err, "failed to get submissions")
+	}
+
+	// file contents are streamed through DownloadFile unless explicitly requested inline.
+	if req.GetIncludeContent() {
+		if err := s.loadContent(ctx, homework.GetFiles()...); err != nil {
+			logger.Error(err, "failed to load file contents", "id", req.GetId())
+			return nil, statusError(err, "failed to load file contents")
+		}
+
+		if err := s.loadSubmissionContent(ctx, homework.GetSubmissions()); err != nil {
+			logger.Error(err, "failed to load file contents", "id", req.GetId())
+			return nil, statusError(err, "failed to load file contents")
+		}
 	}
 
 	logger.V(logLevelDebug).Info("Successfully fetched homework", "id", req.GetId())
@@ -103,57 +326,550 @@ func (s *HomeworkServer) GetHomework(ctx context.Context,
 	return &hpb.GetHomeworkResponse{Hw: homework}, nil
 }
 
+// ListHomeworks lists the homeworks of a course, one page at a time.
+func (s *HomeworkServer) ListHomeworks(ctx context.Context,
+	req *hpb.ListHomeworksRequest,
+) (*hpb.ListHomeworksResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received ListHomeworks request", "courseId", req.GetCourseId(),
+		"pageToken", req.GetPageToken())
+
+	if req.GetCourseId() == "" {
+		return nil, invalidArgument("courseId", "courseId is empty")
+	}
+
+	if !caller.isMember(req.GetCourseId()) {
+		return nil, status.Errorf(codes.PermissionDenied, "not a member of course %s", req.GetCourseId())
+	}
+
+	pageSize := int(req.GetPageSize())
+	if pageSize < 0 {
+		return nil, invalidArgument("pageSize", "pageSize is negative")
+	}
+
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	pageSize = min(pageSize, maxPageSize)
+
+	if _, err := resolveTimestamp(req.GetDueAfter(), ""); err != nil {
+		return nil, invalidArgument("dueAfter", "invalid dueAfter: %v", err)
+	}
+
+	if _, err := resolveTimestamp(req.GetDueBefore(), ""); err != nil {
+		return nil, invalidArgument("dueBefore", "invalid dueBefore: %v", err)
+	}
+
+	query, err := listQueryDigest(req)
+	if err != nil {
+		return nil, statusError(err, "failed to list homeworks")
+	}
+
+	filter := &HomeworkFilter{
+		CourseID:      req.GetCourseId(),
+		DueAfter:      fromTimestamp(req.GetDueAfter()),
+		DueBefore:     fromTimestamp(req.GetDueBefore()),
+		TitleContains: req.GetTitleContains(),
+		OrderBy:       req.GetOrderBy(),
+		Descending:    req.GetDescending(),
+		Limit:         pageSize,
+	}
+
+	if req.GetPageToken() != "" {
+		if filter.After, err = decodePageToken(req.GetPageToken(), query); err != nil {
+			return nil, invalidArgument("pageToken", "invalid pageToken: %v", err)
+		}
+	}
+
+	// get the page of homeworks from the database.
+	homeworks, next, err := s.db.ListHomeworks(ctx, filter)
+	if err != nil {
+		logger.Error(err, "failed to list homeworks", "courseId", req.GetCourseId())
+		return nil, statusError(err, "failed to list homeworks")
+	}
+
+	response := &hpb.ListHomeworksResponse{Homeworks: homeworks}
+
+	if next != nil {
+		if response.NextPageToken, err = encodePageToken(query, next); err != nil {
+			return nil, statusError(err, "failed to list homeworks")
+		}
+	}
+
+	logger.V(logLevelDebug).Info("Successfully listed homeworks", "courseId", req.GetCourseId(),
+		"count", len(homeworks))
+
+	return response, nil
+}
+
 // UpdateHomework updates an existing homework.
 func (s *HomeworkServer) UpdateHomework(ctx context.Context,
 	req *hpb.UpdateHomeworkRequest,
 ) (*hpb.UpdateHomeworkResponse, error) {
-	if _, err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	logger := klog.FromContext(ctx)
 	logger.V(logLevelDebug).Info("Received UpdateHomework request", "id", req.GetHomework().GetId())
 
-	homework := req.GetHomework()
-	if homework == nil {
-		return nil, status.Errorf(codes.InvalidArgument, "homework is nil")
+	update := req.GetHomework()
+	if update == nil {
+		return nil, invalidArgument("homework", "homework is nil")
+	}
+
+	// staff may only edit homeworks of their courses, and only move them between their courses.
+	existing, err := s.authorizeHomework(ctx, update.GetId(), caller.isStaff)
+	if err != nil {
+		return nil, err
+	}
+
+	// a masked update only replaces the listed fields and keeps the others as stored.
+	homework, columns, err := applyUpdateMask(existing, update, req.GetUpdateMask())
+	if err != nil {
+		return nil, err
+	}
+
+	if homework.GetCourseId() != existing.GetCourseId() && !caller.isStaff(homework.GetCourseId()) {
+		return nil, status.Errorf(codes.PermissionDenied, "only staff may move homeworks to course %s",
+			homework.GetCourseId())
+	}
+
+	if homework.GetVersion() <= 0 {
+		return nil, invalidArgument("homework.version", "version is required; send the version last read")
+	}
+
+	// fail early on an edit that is already stale; the database update checks the version again.
+	if homework.GetVersion() != existing.GetVersion() {
+		return nil, status.Errorf(codes.Aborted, "homework %s was modified concurrently: version %d is "+
+			"no longer current, the homework is at version %d", homework.GetId(), homework.GetVersion(),
+			existing.GetVersion())
+	}
+
+	if err := validateHomework(homework); err != nil {
+		return nil, err
 	}
 
-	// update the homework in the database.
-	if err := s.db.UpdateHomework(ctx, homework); err != nil {
+	// move the file contents to the blob store; kept files are already stored.
+	if columns == nil || slices.Contains(columns, "files") {
+		files, err := s.storeFiles(ctx, caller, homework, homework.GetFiles())
+		if err != nil {
+			return nil, err
+		}
+
+		homework.Files = files
+	}
+
+	// update the homework in the database; the response carries the homework as stored.
+	updated, err := s.db.UpdateHomework(ctx, homework, columns)
+	if err != nil {
 		logger.Error(err, "failed to update homework", "id", req.GetHomework().GetId())
-		return nil, status.Errorf(codes.Internal, "failed to update homework: %v", err)
+		return nil, statusError(err, "failed to update homework")
 	}
 
-	logger.V(logLevelDebug).Info("Successfully updated homework", "id", req.GetHomework().GetId())
+	// the blobs of the files the update replaced or removed are no longer referenced.
+	if dropped := droppedContentRefs(existing.GetFiles(), updated.GetFiles()); len(dropped) > 0 {
+		if err := s.db.DeleteStoredFiles(ctx, dropped); err != nil {
+			logger.Error(err, "failed to delete replaced stored files", "id", req.GetHomework().GetId())
+		} else {
+			s.deleteBlobs(ctx, dropped...)
+		}
+	}
+
+	logger.V(logLevelDebug).Info("Successfully updated homework", "id", req.GetHomework().GetId(),
+		"version", updated.GetVersion())
 
-	return &hpb.UpdateHomeworkResponse{Hw: homework}, nil
+	return &hpb.UpdateHomeworkResponse{Hw: updated}, nil
 }
 
 // DeleteHomework deletes a homework by ID.
 func (s *HomeworkServer) DeleteHomework(ctx context.Context,
 	req *hpb.DeleteHomeworkRequest,
 ) (*hpb.DeleteHomeworkResponse, error) {
-	if _, err := s.VerifyToken(ctx, req.GetToken()); err != nil {
-		return nil, fmt.Errorf("authentication failed: %w",
-			status.Error(codes.Unauthenticated, err.Error()))
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	logger := klog.FromContext(ctx)
 	logger.V(logLevelDebug).Info("Received DeleteHomework request", "id", req.GetId())
 
+	if _, err := s.authorizeHomework(ctx, req.GetId(), caller.isStaff); err != nil {
+		return nil, err
+	}
+
 	// delete the homework from the database.
-	if err := s.db.DeleteHomework(ctx, req.GetId()); err != nil {
+	contentRefs, err := s.db.DeleteHomework(ctx, req.GetId())
+	if err != nil {
 		logger.Error(err, "failed to delete homework", "id", req.GetId())
-		return nil, status.Errorf(codes.NotFound, "failed to delete homework: %v", err)
+		return nil, statusError(err, "failed to delete homework %s", req.GetId())
 	}
 
+	s.deleteBlobs(ctx, contentRefs...)
+
 	logger.V(logLevelDebug).Info("Successfully deleted homework", "id", req.GetId())
 
 	return &hpb.DeleteHomeworkResponse{}, nil
 }
 
+// SubmitHomework adds a student submission to a homework.
+func (s *HomeworkServer) SubmitHomework(ctx context.Context,
+	req *hpb.SubmitHomeworkRequest,
+) (*hpb.SubmitHomeworkResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received SubmitHomework request", "id", req.GetId(),
+		"studentId", req.GetSubmission().GetStudentId())
+
+	submission := req.GetSubmission()
+	if submission == nil {
+		return nil, invalidArgument("submission", "submission is nil")
+	}
+
+	if submission.GetStudentId() == "" {
+		return nil, invalidArgument("submission.studentId", "studentId is empty")
+	}
+
+	// students submit only for themselves.
+	homework, err := s.authorizeHomework(ctx, req.GetId(), func(courseID string) bool {
+		return caller.actsFor(courseID, submission.GetStudentId())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePartners(submission.GetStudentId(), submission.GetPartnersId(),
+		homework.GetMaxGroupSize()); err != nil {
+		return nil, invalidArgument("submission.partnersId", "invalid partnersId: %v", err)
+	}
+
+	members, err := s.submissionMembers(ctx, req.GetId(), submission)
+	if err != nil {
+		logger.Error(err, "failed to get group", "id", req.GetId())
+		return nil, statusError(err, "failed to get group")
+	}
+
+	dueDate, err := s.effectiveDueDate(ctx, homework, members...)
+	if err != nil {
+		logger.Error(err, "failed to get extension", "id", req.GetId())
+		return nil, statusError(err, "failed to get extension")
+	}
+
+	// the submission time comes from the server clock, never from the client.
+	submittedAt := s.now()
+	late := assessLateness(dueDate, homework.GetLatePolicy(), submittedAt)
+
+	if late.pastCutoff {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"homework %s no longer accepts submissions: the hard cutoff passed %v after the due date",
+			req.GetId(), homework.GetLatePolicy().GetHardCutoff().AsDuration())
+	}
+
+	submission.SubmissionTime = timestamppb.New(submittedAt)
+	submission.SubmissionTimeText = formatLegacyTime(submittedAt) //nolint:staticcheck // for older clients.
+	submission.IsLate = late.isLate
+	submission.LateBy = durationpb.New(late.lateBy)
+	submission.PenaltyPercent = late.penalty
+
+	if submission.GetSubmissionFile() != nil {
+		file, err := s.storeFile(ctx, caller, homework, submission.GetSubmissionFile())
+		if err != nil {
+			return nil, err
+		}
+
+		submission.SubmissionFile = file
+	}
+
+	// add the submission to the homework in the database.
+	if err := s.db.AddSubmission(ctx, req.GetId(), submission, homework.GetMaxAttempts()); err != nil {
+		logger.Error(err, "failed to submit homework", "id", req.GetId())
+
+		return nil, statusError(err, "failed to submit homework %s", req.GetId())
+	}
+
+	logger.V(logLevelDebug).Info("Successfully submitted homework", "id", req.GetId(),
+		"studentId", submission.GetStudentId(), "version", submission.GetVersion())
+
+	return &hpb.SubmitHomeworkResponse{Submission: submission}, nil
+}
+
+// GetSubmissions retrieves the submissions of a homework visible to the caller.
+func (s *HomeworkServer) GetSubmissions(ctx context.Context,
+	req *hpb.GetSubmissionsRequest,
+) (*hpb.GetSubmissionsResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetSubmissions request", "homeworkId", req.GetHomeworkId())
+
+	homework, err := s.authorizeHomework(ctx, req.GetHomeworkId(), caller.isMember)
+	if err != nil {
+		return nil, err
+	}
+
+	// get the submissions from the database; students only get their own and their group's.
+	submissions, err := s.db.GetSubmissions(ctx, req.GetHomeworkId(),
+		submissionFilter(caller, homework.GetCourseId()))
+	if err != nil {
+		logger.Error(err, "failed to get submissions", "homeworkId", req.GetHomeworkId())
+		return nil, statusError(err, "failed to get submissions")
+	}
+
+	if req.GetIncludeContent() {
+		if err := s.loadSubmissionContent(ctx, submissions); err != nil {
+			logger.Error(err, "failed to load file contents", "homeworkId", req.GetHomeworkId())
+			return nil, statusError(err, "failed to load file contents")
+		}
+	}
+
+	logger.V(logLevelDebug).Info("Successfully fetched submissions", "homeworkId", req.GetHomeworkId(),
+		"count", len(submissions))
+
+	return &hpb.GetSubmissionsResponse{Submissions: submissions}, nil
+}
+
+// GetStudentSubmissions retrieves all submissions of a specific student.
+func (s *HomeworkServer) GetStudentSubmissions(ctx context.Context,
+	req *hpb.GetStudentSubmissionsRequest,
+) (*hpb.GetStudentSubmissionsResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetStudentSubmissions request", "studentId", req.GetStudentId())
+
+	if req.GetStudentId() == "" {
+		return nil, invalidArgument("studentId", "studentId is empty")
+	}
+
+	courses, err := studentRecordCourses(caller, req.GetStudentId())
+	if err != nil {
+		return nil, err
+	}
+
+	// get the student's submissions from the database.
+	submissions, err := s.db.GetStudentSubmissions(ctx, req.GetStudentId(), courses)
+	if err != nil {
+		logger.Error(err, "failed to get student submissions", "studentId", req.GetStudentId())
+		return nil, statusError(err, "failed to get student submissions")
+	}
+
+	if req.GetIncludeContent() {
+		if err := s.loadSubmissionContent(ctx, submissions); err != nil {
+			logger.Error(err, "failed to load file contents", "studentId", req.GetStudentId())
+			return nil, statusError(err, "failed to load file contents")
+		}
+	}
+
+	logger.V(logLevelDebug).Info("Successfully fetched student submissions", "studentId", req.GetStudentId(),
+		"count", len(submissions))
+
+	return &hpb.GetStudentSubmissionsResponse{Submissions: submissions}, nil
+}
+
+// UploadFile stores a file streamed in chunks and returns a reference to it.
+func (s *HomeworkServer) UploadFile(stream hpb.HomeworkService_UploadFileServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err != nil {
+		// authentication failures of clients sending the token in the header pass through as is.
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+
+		return status.Errorf(codes.InvalidArgument, "failed to receive upload header: %v", err)
+	}
+
+	header := first.GetHeader()
+	if header == nil {
+		return invalidArgument("header", "first message must be the upload header")
+	}
+
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received UploadFile request", "homeworkId", header.GetHomeworkId(),
+		"filename", header.GetFilename())
+
+	if header.GetHomeworkId() == "" {
+		return invalidArgument("header.homeworkId", "homeworkId is empty")
+	}
+
+	if _, err := s.authorizeHomework(ctx, header.GetHomeworkId(), caller.isMember); err != nil {
+		return err
+	}
+
+	// stream the chunks into the blob store.
+	reader := &uploadReader{stream: stream}
+
+	ref, err := putBlob(ctx, s.blobs, header.GetFilename(), header.GetMimeType(), reader)
+	if err != nil {
+		logger.Error(err, "failed to store uploaded file", "homeworkId", header.GetHomeworkId())
+
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+
+		return statusError(err, "failed to store uploaded file")
+	}
+
+	// the checksum must be the last message of the stream.
+	if reader.sha256 != "" {
+		if _, err := stream.Recv(); !errors.Is(err, io.EOF) {
+			s.deleteBlobs(ctx, ref.ContentRef)
+			return invalidArgument("sha256", "unexpected message after checksum")
+		}
+	}
+
+	if reader.sha256 == "" || !strings.EqualFold(reader.sha256, ref.SHA256) {
+		s.deleteBlobs(ctx, ref.ContentRef)
+
+		if reader.sha256 == "" {
+			return invalidArgument("sha256", "upload ended without a checksum")
+		}
+
+		return status.Errorf(codes.DataLoss, "checksum mismatch: got %s, computed %s", reader.sha256, ref.SHA256)
+	}
+
+	if err := s.db.AddStoredFile(ctx, header.GetHomeworkId(), caller.id, ref); err != nil {
+		logger.Error(err, "failed to record uploaded file", "contentRef", ref.ContentRef)
+		s.deleteBlobs(ctx, ref.ContentRef)
+
+		return statusError(err, "failed to record uploaded file")
+	}
+
+	logger.V(logLevelDebug).Info("Successfully uploaded file", "homeworkId", header.GetHomeworkId(),
+		"contentRef", ref.ContentRef, "size", ref.Size)
+
+	return stream.SendAndClose(&hpb.UploadFileResponse{File: ref.toProto()})
+}
+
+// DownloadFile streams the content of a homework or submission file in chunks.
+func (s *HomeworkServer) DownloadFile(req *hpb.DownloadFileRequest,
+	stream hpb.HomeworkService_DownloadFileServer,
+) error {
+	ctx := stream.Context()
+
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received DownloadFile request", "homeworkId", req.GetHomeworkId(),
+		"contentRef", req.GetContentRef(), "offset", req.GetOffset())
+
+	homework, err := s.authorizeHomework(ctx, req.GetHomeworkId(), caller.isMember)
+	if err != nil {
+		return err
+	}
+
+	// the file must belong to the requested homework.
+	stored, err := s.db.GetStoredFile(ctx, req.GetContentRef())
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && stored.HomeworkID != req.GetHomeworkId()) {
+		return status.Errorf(codes.NotFound, "contentRef %q not found for homework %s",
+			req.GetContentRef(), req.GetHomeworkId())
+	}
+
+	if err != nil {
+		logger.Error(err, "failed to get stored file", "contentRef", req.GetContentRef())
+		return statusError(err, "failed to get stored file")
+	}
+
+	allowed, err := s.canDownload(ctx, caller, homework, stored)
+	if err != nil {
+		logger.Error(err, "failed to check file access", "contentRef", stored.ContentRef)
+		return statusError(err, "failed to check file access")
+	}
+
+	if !allowed {
+		return status.Errorf(codes.PermissionDenied, "not allowed to download contentRef %q", stored.ContentRef)
+	}
+
+	if req.GetOffset() < 0 || req.GetOffset() > stored.Size {
+		return status.Errorf(codes.OutOfRange, "offset %d is outside of the file size %d",
+			req.GetOffset(), stored.Size)
+	}
+
+	// nothing follows the end of the file; only its metadata is sent, without asking the blob
+	// store, which may reject a read starting there.
+	if req.GetOffset() == stored.Size {
+		return stream.Send(&hpb.DownloadFileResponse{File: stored.fileRef().toProto(), Offset: stored.Size})
+	}
+
+	reader, err := s.blobs.Get(ctx, stored.ContentRef, req.GetOffset())
+	if err != nil {
+		logger.Error(err, "failed to open file content", "contentRef", stored.ContentRef)
+		return statusError(err, "failed to open file content")
+	}
+	defer reader.Close()
+
+	file := stored.fileRef().toProto()
+	offset := req.GetOffset()
+	buf := make([]byte, downloadChunkSize)
+
+	for {
+		n, err := io.ReadFull(reader, buf)
+		if n > 0 || file != nil {
+			if sendErr := stream.Send(&hpb.DownloadFileResponse{
+				File:   file,
+				Offset: offset,
+				Chunk:  buf[:n],
+			}); sendErr != nil {
+				return sendErr
+			}
+
+			file = nil
+			offset += int64(n)
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if err != nil {
+			logger.Error(err, "failed to read file content", "contentRef", stored.ContentRef)
+			return statusError(err, "failed to read file content")
+		}
+	}
+
+	logger.V(logLevelDebug).Info("Successfully downloaded file", "homeworkId", req.GetHomeworkId(),
+		"contentRef", stored.ContentRef, "bytes", offset-req.GetOffset())
+
+	return nil
+}
+
+// newGRPCServer creates a gRPC server serving the homework service, with every call authenticated.
+func (s *HomeworkServer) newGRPCServer() *grpc.Server {
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(s.unaryAuthInterceptor),
+		grpc.StreamInterceptor(s.streamAuthInterceptor),
+	)
+	hpb.RegisterHomeworkServiceServer(grpcServer, s)
+
+	return grpcServer
+}
+
 // main StudentsServer function.
 func main() {
 	// init klog
@@ -164,6 +880,15 @@ func main() {
     klog.Warning("Warning: No .env file loaded, proceeding with environment variables only")
 }
 
+	// "migrate up|down|status" manages the database schema instead of serving.
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrateCommand(context.Background(), flag.Arg(1)); err != nil {
+			klog.Fatalf("Failed to migrate: %v", err)
+		}
+
+		return
+	}
+
 	// init the StudentsServer
 	server, err := initHomeworkMicroserviceServer()
 	if err != nil {
@@ -180,8 +905,7 @@ func main() {
 
 	klog.Info("Starting Homework on port: ", address)
 	// create a grpc HomeworkServer
-	grpcServer := grpc.NewServer()
-	hpb.RegisterHomeworkServiceServer(grpcServer, server)
+	grpcServer := server.newGRPCServer()
 
 	// serve the grpc StudentsServer
 	if err := grpcServer.Serve(lis); err != nil {
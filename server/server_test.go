@@ -0,0 +1,714 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	ms "github.com/TekClinic/MicroService-Lib"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	testCourse      = "course-1"
+	testOtherCourse = "course-2"
+	// testSignature signs every token accepted by testBase.
+	testSignature = "test-signature"
+	bufSize       = 1 << 20
+)
+
+// testClaims are the claims of a token accepted by testBase.
+type testClaims struct {
+	roles sets.Set[string]
+}
+
+func (c testClaims) HasRole(role string) bool {
+	return c.roles.Has(role)
+}
+
+func (c testClaims) GetRoles() sets.Set[string] {
+	return c.roles
+}
+
+// testBase stands in for the auth provider: it accepts the tokens made by testToken
+// and takes the roles from their payload.
+type testBase struct{}
+
+func (testBase) VerifyToken(_ context.Context, rawToken string) (ms.Claims, error) {
+	parts := strings.Split(rawToken, ".")
+	if len(parts) != 3 || parts[2] != testSignature {
+		return nil, errors.New("invalid signature")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	var claims struct {
+		Roles []string `json:"roles"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, err
+	}
+
+	return testClaims{roles: sets.New(claims.Roles...)}, nil
+}
+
+func (testBase) GetPort() string {
+	return "test"
+}
+
+// testToken makes a token of the given subject and roles that testBase accepts.
+func testToken(t *testing.T, subject string, roles ...string) string {
+	t.Helper()
+
+	payload, err := json.Marshal(map[string]any{"sub": subject, "roles": roles})
+	if err != nil {
+		t.Fatalf("failed to marshal token payload: %v", err)
+	}
+
+	return strings.Join([]string{
+		base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)),
+		base64.RawURLEncoding.EncodeToString(payload),
+		testSignature,
+	}, ".")
+}
+
+// newValidationServer returns a server without storage, for requests that are rejected
+// before it is reached, together with the context of an admin passing every role check.
+func newValidationServer(t *testing.T) (*HomeworkServer, context.Context) {
+	t.Helper()
+
+	server := &HomeworkServer{BaseServiceServer: testBase{}, subjectClaim: defaultSubjectClaim}
+
+	caller, err := server.authenticate(context.Background(), testToken(t, "admin-1", roleAdmin))
+	if err != nil {
+		t.Fatalf("failed to authenticate: %v", err)
+	}
+
+	return server, context.WithValue(context.Background(), callerKey{}, &authState{caller: caller})
+}
+
+// newTestServer serves a HomeworkServer backed by the in-memory storage and a local blob
+// store over an in-process listener and returns it with a client connected to it.
+func newTestServer(t *testing.T) (*HomeworkServer, hpb.HomeworkServiceClient) {
+	t.Helper()
+
+	blobs, err := NewLocalBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to create blob store: %v", err)
+	}
+
+	server := &HomeworkServer{
+		BaseServiceServer: testBase{},
+		db:                NewMemoryStorage(),
+		blobs:             blobs,
+		now:               time.Now,
+		subjectClaim:      defaultSubjectClaim,
+	}
+
+	lis := bufconn.Listen(bufSize)
+	grpcServer := server.newGRPCServer()
+
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			t.Errorf("failed to serve: %v", err)
+		}
+	}()
+
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to dial server: %v", err)
+	}
+
+	t.Cleanup(func() { conn.Close() })
+
+	return server, hpb.NewHomeworkServiceClient(conn)
+}
+
+// withToken returns a context sending the token as bearer metadata.
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), authorizationHeader, "Bearer "+token)
+}
+
+// courseTest is a test server with homeworks of testCourse and the contexts of a staff
+// member and a student of the course.
+type courseTest struct {
+	server  *HomeworkServer
+	client  hpb.HomeworkServiceClient
+	staff   context.Context
+	student context.Context
+}
+
+// newCourseTest serves a new test server and creates the homeworks on it as "teacher",
+// a staff member of testCourse. The student is "student-1".
+func newCourseTest(t *testing.T, homeworks ...*hpb.Homework) *courseTest {
+	t.Helper()
+
+	server, client := newTestServer(t)
+	course := &courseTest{
+		server:  server,
+		client:  client,
+		staff:   withToken(testToken(t, "teacher", roleStaff+courseRoleSeparator+testCourse)),
+		student: withToken(testToken(t, "student-1", roleStudent+courseRoleSeparator+testCourse)),
+	}
+
+	for _, homework := range homeworks {
+		if _, err := client.CreateHomework(course.staff, &hpb.CreateHomeworkRequest{Homework: homework}); err != nil {
+			t.Fatalf("CreateHomework %s: %v", homework.GetId(), err)
+		}
+	}
+
+	return course
+}
+
+// asStudent returns the context of another student of testCourse.
+func (c *courseTest) asStudent(t *testing.T, studentID string) context.Context {
+	t.Helper()
+
+	return withToken(testToken(t, studentID, roleStudent+courseRoleSeparator+testCourse))
+}
+
+// wantCode fails the test unless err carries the given status code.
+func wantCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+
+	if got := status.Code(err); got != code {
+		t.Fatalf("got code %v (%v), want %v", got, err, code)
+	}
+}
+
+func newTestHomework(id string) *hpb.Homework {
+	return &hpb.Homework{
+		Id:          id,
+		CourseId:    testCourse,
+		Title:       "Linked lists",
+		Description: "Implement a linked list.",
+		DueDate:     timestamppb.New(time.Now().Add(24 * time.Hour).Truncate(time.Second)),
+		MaxAttempts: 2,
+		Files:       []*hpb.File{{Filename: "spec.txt", MimeType: "text/plain", Content: []byte("spec")}},
+	}
+}
+
+func TestSubmissionRequestValidation(t *testing.T) {
+	// every request below is rejected before the database is reached.
+	server, ctx := newValidationServer(t)
+
+	tests := []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{"no submission", func() error {
+			_, err := server.SubmitHomework(ctx, &hpb.SubmitHomeworkRequest{Id: "hw-1"})
+			return err
+		}, codes.InvalidArgument},
+		{"no student", func() error {
+			_, err := server.SubmitHomework(ctx, &hpb.SubmitHomeworkRequest{
+				Id:         "hw-1",
+				Submission: &hpb.Submission{},
+			})
+			return err
+		}, codes.InvalidArgument},
+		{"student submissions without a student", func() error {
+			_, err := server.GetStudentSubmissions(ctx, &hpb.GetStudentSubmissionsRequest{})
+			return err
+		}, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantCode(t, tt.call(), tt.want)
+		})
+	}
+}
+
+func TestPageToken(t *testing.T) {
+	query, err := listQueryDigest(&hpb.ListHomeworksRequest{CourseId: "course-1", TitleContains: "lab"})
+	if err != nil {
+		t.Fatalf("listQueryDigest: %v", err)
+	}
+
+	// the page size and page token do not change the query.
+	same, err := listQueryDigest(&hpb.ListHomeworksRequest{
+		CourseId: "course-1", TitleContains: "lab", PageSize: 5, PageToken: "next",
+	})
+	if err != nil || same != query {
+		t.Fatalf("digest of the same query = %q (%v), want %q", same, err, query)
+	}
+
+	other, err := listQueryDigest(&hpb.ListHomeworksRequest{CourseId: "course-1", Descending: true})
+	if err != nil || other == query {
+		t.Fatalf("digest of another query = %q (%v), want it to differ", other, err)
+	}
+
+	cursor := &HomeworkCursor{Key: "2026-10-17", ID: "hw-1"}
+
+	token, err := encodePageToken(query, cursor)
+	if err != nil {
+		t.Fatalf("encodePageToken: %v", err)
+	}
+
+	if got, err := decodePageToken(token, query); err != nil || *got != *cursor {
+		t.Fatalf("decodePageToken = %v (%v), want %v", got, err, cursor)
+	}
+
+	if _, err := decodePageToken(token, other); err == nil {
+		t.Fatal("decodePageToken accepted a token of another query")
+	}
+
+	if _, err := decodePageToken("not a token", query); err == nil {
+		t.Fatal("decodePageToken accepted a malformed token")
+	}
+}
+
+func TestListHomeworksRequestValidation(t *testing.T) {
+	server, ctx := newValidationServer(t)
+
+	tests := []struct {
+		name string
+		req  *hpb.ListHomeworksRequest
+		want codes.Code
+	}{
+		{"no course", &hpb.ListHomeworksRequest{}, codes.InvalidArgument},
+		{"negative page size", &hpb.ListHomeworksRequest{
+			CourseId: "course-1", PageSize: -1,
+		}, codes.InvalidArgument},
+		{"malformed page token", &hpb.ListHomeworksRequest{
+			CourseId: "course-1", PageToken: "not a token",
+		}, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := server.ListHomeworks(ctx, tt.req)
+			wantCode(t, err, tt.want)
+		})
+	}
+}
+
+func TestHomeworkLifecycle(t *testing.T) {
+	course := newCourseTest(t)
+	client, staff := course.client, course.staff
+
+	created, err := client.CreateHomework(staff, &hpb.CreateHomeworkRequest{Homework: newTestHomework("hw-1")})
+	if err != nil {
+		t.Fatalf("CreateHomework: %v", err)
+	}
+
+	if ref := created.GetHw().GetFiles()[0].GetContentRef(); ref == "" {
+		t.Fatal("CreateHomework did not store the file content")
+	}
+
+	if created.GetHw().GetVersion() != 1 {
+		t.Fatalf("CreateHomework returned version %d, want 1", created.GetHw().GetVersion())
+	}
+
+	got, err := client.GetHomework(staff, &hpb.GetHomeworkRequest{Id: "hw-1", IncludeContent: true})
+	if err != nil {
+		t.Fatalf("GetHomework: %v", err)
+	}
+
+	if got.GetHw().GetTitle() != "Linked lists" || got.GetHw().GetCreatedAt() == nil {
+		t.Fatalf("GetHomework returned %v", got.GetHw())
+	}
+
+	if content := got.GetHw().GetFiles()[0].GetContent(); !bytes.Equal(content, []byte("spec")) {
+		t.Fatalf("GetHomework returned file content %q, want %q", content, "spec")
+	}
+
+	update := got.GetHw()
+	update.Title = "Doubly linked lists"
+
+	resp, err := client.UpdateHomework(staff, &hpb.UpdateHomeworkRequest{Homework: update})
+	if err != nil {
+		t.Fatalf("UpdateHomework: %v", err)
+	}
+
+	if resp.GetHw().GetVersion() != 2 {
+		t.Fatalf("UpdateHomework returned version %d, want 2", resp.GetHw().GetVersion())
+	}
+
+	updated, err := client.GetHomework(staff, &hpb.GetHomeworkRequest{Id: "hw-1"})
+	if err != nil {
+		t.Fatalf("GetHomework: %v", err)
+	}
+
+	if updated.GetHw().GetTitle() != "Doubly linked lists" {
+		t.Fatalf("UpdateHomework did not change the title: %q", updated.GetHw().GetTitle())
+	}
+
+	if !updated.GetHw().GetCreatedAt().AsTime().Equal(got.GetHw().GetCreatedAt().AsTime()) {
+		t.Fatal("UpdateHomework changed the creation time")
+	}
+
+	if _, err := client.DeleteHomework(staff, &hpb.DeleteHomeworkRequest{Id: "hw-1"}); err != nil {
+		t.Fatalf("DeleteHomework: %v", err)
+	}
+
+	_, err = client.GetHomework(staff, &hpb.GetHomeworkRequest{Id: "hw-1"})
+	wantCode(t, err, codes.NotFound)
+}
+
+func TestHomeworkErrorCodes(t *testing.T) {
+	course := newCourseTest(t, newTestHomework("hw-1"))
+	client, staff, student := course.client, course.staff, course.student
+	otherStaff := withToken(testToken(t, "other-teacher", roleStaff+courseRoleSeparator+testOtherCourse))
+	outsider := withToken(testToken(t, "student-2", roleStudent+courseRoleSeparator+testOtherCourse))
+
+	tests := []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{"no token", func() error {
+			_, err := client.GetHomework(context.Background(), &hpb.GetHomeworkRequest{Id: "hw-1"})
+			return err
+		}, codes.Unauthenticated},
+		{"forged token", func() error {
+			forged := strings.TrimSuffix(testToken(t, "teacher", roleAdmin), testSignature) + "forged"
+			_, err := client.GetHomework(withToken(forged), &hpb.GetHomeworkRequest{Id: "hw-1"})
+			return err
+		}, codes.Unauthenticated},
+		{"student creates", func() error {
+			_, err := client.CreateHomework(student, &hpb.CreateHomeworkRequest{Homework: newTestHomework("hw-2")})
+			return err
+		}, codes.PermissionDenied},
+		{"duplicate id", func() error {
+			_, err := client.CreateHomework(staff, &hpb.CreateHomeworkRequest{Homework: newTestHomework("hw-1")})
+			return err
+		}, codes.AlreadyExists},
+		{"missing homework", func() error {
+			_, err := client.CreateHomework(staff, &hpb.CreateHomeworkRequest{})
+			return err
+		}, codes.InvalidArgument},
+		{"get unknown", func() error {
+			_, err := client.GetHomework(staff, &hpb.GetHomeworkRequest{Id: "unknown"})
+			return err
+		}, codes.NotFound},
+		{"get from another course", func() error {
+			_, err := client.GetHomework(outsider, &hpb.GetHomeworkRequest{Id: "hw-1"})
+			return err
+		}, codes.PermissionDenied},
+		{"update unknown", func() error {
+			_, err := client.UpdateHomework(staff, &hpb.UpdateHomeworkRequest{Homework: newTestHomework("unknown")})
+			return err
+		}, codes.NotFound},
+		{"update by student", func() error {
+			_, err := client.UpdateHomework(student, &hpb.UpdateHomeworkRequest{Homework: newTestHomework("hw-1")})
+			return err
+		}, codes.PermissionDenied},
+		{"delete by staff of another course", func() error {
+			_, err := client.DeleteHomework(otherStaff, &hpb.DeleteHomeworkRequest{Id: "hw-1"})
+			return err
+		}, codes.PermissionDenied},
+		{"delete unknown", func() error {
+			_, err := client.DeleteHomework(staff, &hpb.DeleteHomeworkRequest{Id: "unknown"})
+			return err
+		}, codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantCode(t, tt.call(), tt.want)
+		})
+	}
+
+	// the homework survives every rejected call.
+	if _, err := client.GetHomework(student, &hpb.GetHomeworkRequest{Id: "hw-1"}); err != nil {
+		t.Fatalf("GetHomework: %v", err)
+	}
+}
+
+func TestUpdateHomeworkVersionConflict(t *testing.T) {
+	course := newCourseTest(t, newTestHomework("hw-1"))
+	client, staff := course.client, course.staff
+
+	// two editors read the same version.
+	first, err := client.GetHomework(staff, &hpb.GetHomeworkRequest{Id: "hw-1"})
+	if err != nil {
+		t.Fatalf("GetHomework: %v", err)
+	}
+
+	second, err := client.GetHomework(staff, &hpb.GetHomeworkRequest{Id: "hw-1"})
+	if err != nil {
+		t.Fatalf("GetHomework: %v", err)
+	}
+
+	first.GetHw().Title = "First edit"
+	if _, err := client.UpdateHomework(staff, &hpb.UpdateHomeworkRequest{Homework: first.GetHw()}); err != nil {
+		t.Fatalf("UpdateHomework: %v", err)
+	}
+
+	second.GetHw().Title = "Second edit"
+	_, err = client.UpdateHomework(staff, &hpb.UpdateHomeworkRequest{Homework: second.GetHw()})
+	wantCode(t, err, codes.Aborted)
+
+	second.GetHw().Version = 0
+	_, err = client.UpdateHomework(staff, &hpb.UpdateHomeworkRequest{Homework: second.GetHw()})
+	wantCode(t, err, codes.InvalidArgument)
+
+	got, err := client.GetHomework(staff, &hpb.GetHomeworkRequest{Id: "hw-1"})
+	if err != nil {
+		t.Fatalf("GetHomework: %v", err)
+	}
+
+	if got.GetHw().GetTitle() != "First edit" {
+		t.Fatalf("the stale update overwrote the title with %q", got.GetHw().GetTitle())
+	}
+}
+
+func TestUpdateHomeworkMask(t *testing.T) {
+	course := newCourseTest(t, newTestHomework("hw-1"))
+	client, staff := course.client, course.staff
+
+	// only the due date is sent; the other fields are left empty.
+	dueDate := timestamppb.New(time.Now().Add(48 * time.Hour).Truncate(time.Second))
+	update := &hpb.Homework{Id: "hw-1", DueDate: dueDate, Version: 1}
+
+	resp, err := client.UpdateHomework(staff, &hpb.UpdateHomeworkRequest{
+		Homework:   update,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"dueDate"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateHomework: %v", err)
+	}
+
+	if resp.GetHw().GetVersion() != 2 {
+		t.Fatalf("UpdateHomework returned version %d, want 2", resp.GetHw().GetVersion())
+	}
+
+	got, err := client.GetHomework(staff, &hpb.GetHomeworkRequest{Id: "hw-1"})
+	if err != nil {
+		t.Fatalf("GetHomework: %v", err)
+	}
+
+	if !got.GetHw().GetDueDate().AsTime().Equal(dueDate.AsTime()) {
+		t.Fatalf("UpdateHomework set the due date to %v, want %v", got.GetHw().GetDueDate().AsTime(), dueDate.AsTime())
+	}
+
+	if got.GetHw().GetTitle() != "Linked lists" || len(got.GetHw().GetFiles()) != 1 {
+		t.Fatalf("UpdateHomework changed fields outside the mask: %v", got.GetHw())
+	}
+
+	for _, path := range []string{"version", "submissions", "unknown"} {
+		_, err = client.UpdateHomework(staff, &hpb.UpdateHomeworkRequest{
+			Homework:   &hpb.Homework{Id: "hw-1", Version: 2},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{path}},
+		})
+		wantCode(t, err, codes.InvalidArgument)
+	}
+}
+
+func TestUpdateHomeworkReturnsStoredHomework(t *testing.T) {
+	course := newCourseTest(t, newTestHomework("hw-1"))
+	client, staff := course.client, course.staff
+
+	stored, err := client.GetHomework(staff, &hpb.GetHomeworkRequest{Id: "hw-1"})
+	if err != nil {
+		t.Fatalf("GetHomework: %v", err)
+	}
+
+	// read-only fields sent by the client are not stored, so they are not returned either.
+	update := newTestHomework("hw-1")
+	update.Version = 1
+	update.Title = "Doubly linked lists"
+	update.CreatedAt = timestamppb.New(time.Unix(0, 0))
+	update.Submissions = []*hpb.Submission{{Id: "forged", StudentId: "student-1"}}
+
+	resp, err := client.UpdateHomework(staff, &hpb.UpdateHomeworkRequest{Homework: update})
+	if err != nil {
+		t.Fatalf("UpdateHomework: %v", err)
+	}
+
+	got := resp.GetHw()
+	if got.GetTitle() != "Doubly linked lists" || got.GetVersion() != 2 || len(got.GetSubmissions()) != 0 {
+		t.Fatalf("UpdateHomework returned %v, want the stored homework at version 2", got)
+	}
+
+	if !got.GetCreatedAt().AsTime().Equal(stored.GetHw().GetCreatedAt().AsTime()) {
+		t.Fatalf("UpdateHomework returned creation time %v, want %v", got.GetCreatedAt().AsTime(),
+			stored.GetHw().GetCreatedAt().AsTime())
+	}
+
+	if file := got.GetFiles()[0]; file.GetContentRef() == "" || len(file.GetContent()) != 0 {
+		t.Fatalf("UpdateHomework returned file %v, want its stored metadata", file)
+	}
+}
+
+func TestInvalidHomeworkFieldViolation(t *testing.T) {
+	course := newCourseTest(t)
+
+	homework := newTestHomework("hw-1")
+	homework.MaxAttempts = -1
+
+	_, err := course.client.CreateHomework(course.staff, &hpb.CreateHomeworkRequest{Homework: homework})
+	wantCode(t, err, codes.InvalidArgument)
+
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok &&
+			badRequest.GetFieldViolations()[0].GetField() == "homework.maxAttempts" {
+			return
+		}
+	}
+
+	t.Fatalf("CreateHomework error %v has no field violation of homework.maxAttempts", err)
+}
+
+func TestSubmitHomeworkAttempts(t *testing.T) {
+	course := newCourseTest(t, newTestHomework("hw-1"))
+	client, student := course.client, course.student
+
+	for version := int32(1); version <= 2; version++ {
+		resp, err := client.SubmitHomework(student, &hpb.SubmitHomeworkRequest{
+			Id:         "hw-1",
+			Submission: &hpb.Submission{StudentId: "student-1"},
+		})
+		if err != nil {
+			t.Fatalf("SubmitHomework: %v", err)
+		}
+
+		if got := resp.GetSubmission().GetVersion(); got != version {
+			t.Fatalf("SubmitHomework returned version %d, want %d", got, version)
+		}
+	}
+
+	_, err := client.SubmitHomework(student, &hpb.SubmitHomeworkRequest{
+		Id:         "hw-1",
+		Submission: &hpb.Submission{StudentId: "student-1"},
+	})
+	wantCode(t, err, codes.FailedPrecondition)
+
+	_, err = client.SubmitHomework(student, &hpb.SubmitHomeworkRequest{
+		Id:         "hw-1",
+		Submission: &hpb.Submission{StudentId: "student-2"},
+	})
+	wantCode(t, err, codes.PermissionDenied)
+}
+
+func TestListHomeworks(t *testing.T) {
+	base := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+
+	var homeworks []*hpb.Homework
+
+	for i, title := range []string{"Arrays", "Linked lists", "Trees", "Graphs", "Hash tables"} {
+		homework := newTestHomework(fmt.Sprintf("hw-%d", i+1))
+		homework.Title = title
+		homework.DueDate = timestamppb.New(base.Add(time.Duration(i) * 24 * time.Hour))
+		homeworks = append(homeworks, homework)
+	}
+
+	course := newCourseTest(t, homeworks...)
+	client, student := course.client, course.student
+	outsider := withToken(testToken(t, "student-2", roleStudent+courseRoleSeparator+testOtherCourse))
+
+	other := newTestHomework("hw-other")
+	other.CourseId = testOtherCourse
+
+	if _, err := client.CreateHomework(withToken(testToken(t, "admin", roleAdmin)),
+		&hpb.CreateHomeworkRequest{Homework: other}); err != nil {
+		t.Fatalf("CreateHomework: %v", err)
+	}
+
+	// list reads every page of the request and returns the homework IDs in order.
+	list := func(req *hpb.ListHomeworksRequest) []string {
+		t.Helper()
+
+		var ids []string
+
+		for {
+			resp, err := client.ListHomeworks(student, req)
+			if err != nil {
+				t.Fatalf("ListHomeworks: %v", err)
+			}
+
+			for _, homework := range resp.GetHomeworks() {
+				if len(homework.GetSubmissions()) != 0 {
+					t.Fatalf("ListHomeworks returned the submissions of %s", homework.GetId())
+				}
+
+				ids = append(ids, homework.GetId())
+			}
+
+			if resp.GetNextPageToken() == "" {
+				return ids
+			}
+
+			req.PageToken = resp.GetNextPageToken()
+		}
+	}
+
+	tests := []struct {
+		name string
+		req  *hpb.ListHomeworksRequest
+		want []string
+	}{
+		{"by due date in pages", &hpb.ListHomeworksRequest{CourseId: testCourse, PageSize: 2},
+			[]string{"hw-1", "hw-2", "hw-3", "hw-4", "hw-5"}},
+		{"descending", &hpb.ListHomeworksRequest{CourseId: testCourse, PageSize: 3, Descending: true},
+			[]string{"hw-5", "hw-4", "hw-3", "hw-2", "hw-1"}},
+		{"by creation", &hpb.ListHomeworksRequest{
+			CourseId: testCourse, PageSize: 1, OrderBy: hpb.HomeworkOrder_HOMEWORK_ORDER_CREATED_AT,
+		}, []string{"hw-1", "hw-2", "hw-3", "hw-4", "hw-5"}},
+		{"due between", &hpb.ListHomeworksRequest{
+			CourseId:  testCourse,
+			DueAfter:  timestamppb.New(base.Add(24 * time.Hour)),
+			DueBefore: timestamppb.New(base.Add(3 * 24 * time.Hour)),
+		}, []string{"hw-2", "hw-3"}},
+		{"title", &hpb.ListHomeworksRequest{CourseId: testCourse, TitleContains: "LIST"}, []string{"hw-2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := list(tt.req); !slices.Equal(got, tt.want) {
+				t.Fatalf("ListHomeworks = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	first, err := client.ListHomeworks(student, &hpb.ListHomeworksRequest{CourseId: testCourse, PageSize: 2})
+	if err != nil {
+		t.Fatalf("ListHomeworks: %v", err)
+	}
+
+	// a page token only continues the listing it was issued for.
+	tampered := base64.RawURLEncoding.EncodeToString([]byte(`{"q":"forged","c":{"id":"hw-1"}}`))
+
+	for name, req := range map[string]*hpb.ListHomeworksRequest{
+		"other filters": {CourseId: testCourse, PageSize: 2, Descending: true, PageToken: first.GetNextPageToken()},
+		"tampered":      {CourseId: testCourse, PageToken: tampered},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.ListHomeworks(student, req)
+			wantCode(t, err, codes.InvalidArgument)
+		})
+	}
+
+	_, err = client.ListHomeworks(outsider, &hpb.ListHomeworksRequest{CourseId: testCourse})
+	wantCode(t, err, codes.PermissionDenied)
+}
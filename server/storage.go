@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	ms "github.com/TekClinic/MicroService-Lib"
+)
+
+const (
+	storagePostgres = "postgres"
+	storageMemory   = "memory"
+)
+
+// errAlreadyExists is returned by storages without unique constraints of their own when a
+// record with the same key is inserted twice.
+var errAlreadyExists = errors.New("already exists")
+
+// Storage persists homeworks and everything recorded against them. Missing records are
+// reported with errors wrapping sql.ErrNoRows, whichever the implementation.
+type Storage interface {
+	// AddHomework adds a homework at version 1, setting the homework's version.
+	AddHomework(ctx context.Context, homework *hpb.Homework) error
+	// GetHomework retrieves a homework by ID, without its submissions.
+	GetHomework(ctx context.Context, id string) (*hpb.Homework, error)
+	// ListHomeworks retrieves one page of a course's homeworks matching the filter and a cursor
+	// to the last homework of the page, or nil when no homeworks follow it.
+	ListHomeworks(ctx context.Context, filter *HomeworkFilter) ([]*hpb.Homework, *HomeworkCursor, error)
+	// UpdateHomework replaces an existing homework, keeping its submissions and creation time.
+	// Only the given columns are written, or all of them when columns is nil.
+	// It fails with errStaleVersion unless the stored homework is at the homework's version,
+	// and returns the homework as stored, at its new version.
+	UpdateHomework(ctx context.Context, homework *hpb.Homework, columns []string) (*hpb.Homework, error)
+	// DeleteHomework removes a homework with everything recorded against it and returns the
+	// content references of its stored files.
+	DeleteHomework(ctx context.Context, id string) ([]string, error)
+
+	// AddStoredFile records a blob stored for a homework and the ID of the caller who uploaded it.
+	AddStoredFile(ctx context.Context, homeworkID, uploadedBy string, ref *FileRef) error
+	// GetStoredFile retrieves the record of a stored blob by its content reference.
+	GetStoredFile(ctx context.Context, contentRef string) (*StoredFile, error)
+	// DeleteStoredFiles removes the records of the given stored blobs.
+	DeleteStoredFiles(ctx context.Context, contentRefs []string) error
+
+	// AddSubmission inserts a submission as the next, final, version of the student's or group's
+	// submissions, forming the group on its first submission. maxAttempts of 0 is unlimited.
+	AddSubmission(ctx context.Context, homeworkID string, submission *hpb.Submission, maxAttempts int32) error
+	// GroupMembers returns the members of the student's group, or nil when the student is not in one.
+	GroupMembers(ctx context.Context, homeworkID, studentID string) ([]string, error)
+	// GetSubmission retrieves a single submission version by ID.
+	GetSubmission(ctx context.Context, id string) (*hpb.Submission, error)
+	// SelectFinalSubmission marks a submission version as final in place of the previous one.
+	SelectFinalSubmission(ctx context.Context, id string) (*hpb.Submission, error)
+	// GetSubmissions retrieves the final submissions of a homework, only the student's and
+	// their group's when studentID is not empty.
+	GetSubmissions(ctx context.Context, homeworkID, studentID string) ([]*hpb.Submission, error)
+	// HasSubmissionFile reports whether a version submitted by the student or their group holds the file.
+	HasSubmissionFile(ctx context.Context, homeworkID, studentID, contentRef string) (bool, error)
+	// GetStudentSubmissions retrieves the final submissions of a student and their groups, only
+	// on homeworks of the given courses when courseIDs is not nil.
+	GetStudentSubmissions(ctx context.Context, studentID string, courseIDs []string) ([]*hpb.Submission, error)
+	// ListSubmissionVersions retrieves every version of a student's or group's submission, oldest first.
+	ListSubmissionVersions(ctx context.Context, homeworkID, studentID string) ([]*hpb.Submission, error)
+
+	// GrantExtensions inserts extensions, replacing existing ones of the same students.
+	GrantExtensions(ctx context.Context, extensions []*hpb.Extension) ([]*hpb.Extension, error)
+	// RevokeExtensions removes the extensions of the given students on a homework.
+	RevokeExtensions(ctx context.Context, homeworkID string, studentIDs []string) error
+	// ListExtensions retrieves all extensions granted on a homework.
+	ListExtensions(ctx context.Context, homeworkID string) ([]*hpb.Extension, error)
+	// GetExtension retrieves a student's extension on a homework.
+	GetExtension(ctx context.Context, homeworkID, studentID string) (*hpb.Extension, error)
+
+	// SetGrade inserts the grade of a submission, replacing its previous grade.
+	SetGrade(ctx context.Context, grade *hpb.Grade) (*hpb.Grade, error)
+	// GetGrades retrieves the grades of a homework's final submissions.
+	GetGrades(ctx context.Context, homeworkID string) ([]*hpb.Grade, error)
+	// GetStudentGrades retrieves the grades of a student's and their groups' final submissions,
+	// only on homeworks of the given courses when courseIDs is not nil.
+	GetStudentGrades(ctx context.Context, studentID string, courseIDs []string) ([]*hpb.Grade, error)
+
+	// AddRegrade opens a regrade request on a graded submission.
+	AddRegrade(ctx context.Context, regrade *hpb.Regrade) (*hpb.Regrade, error)
+	// GetRegrade retrieves a regrade request by ID.
+	GetRegrade(ctx context.Context, id string) (*hpb.Regrade, error)
+	// ResolveRegrade closes an open regrade request, returning the grade when its score was adjusted;
+	// an adjusted score clears the rubric selections of the grade.
+	ResolveRegrade(ctx context.Context, resolution *hpb.Regrade) (*hpb.Regrade, *hpb.Grade, error)
+	// ListOpenRegrades retrieves the open regrade requests of a course, oldest first.
+	ListOpenRegrades(ctx context.Context, courseID string) ([]*hpb.Regrade, error)
+
+	// AdvanceWorkflow completes the student's next step of a homework's workflow.
+	AdvanceWorkflow(ctx context.Context, homeworkID, studentID, step string, steps []string,
+		completedAt time.Time) (*hpb.WorkflowProgress, error)
+	// ListWorkflowProgress retrieves the workflow progress of a student, or of every student
+	// who started the workflow when studentID is empty.
+	ListWorkflowProgress(ctx context.Context, homeworkID, studentID string) ([]*hpb.WorkflowProgress, error)
+}
+
+var (
+	_ Storage = (*Database)(nil)
+	_ Storage = (*MemoryStorage)(nil)
+)
+
+// InitializeStorage creates the storage selected by the STORAGE environment variable.
+func InitializeStorage() (Storage, error) {
+	switch kind := ms.GetOptionalEnv("STORAGE", storagePostgres); kind {
+	case storagePostgres:
+		database, err := InitializeDatabase()
+		if err != nil {
+			return nil, err
+		}
+
+		return database, nil
+	case storageMemory:
+		return NewMemoryStorage(), nil
+	default:
+		return nil, fmt.Errorf("unknown storage %q", kind)
+	}
+}
@@ -0,0 +1,440 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// forEachStorage runs a test of the Storage contract against the in-memory storage and,
+// when TEST_DSN names a PostgreSQL database the tests may empty, against the database.
+func forEachStorage(t *testing.T, test func(t *testing.T, store Storage)) {
+	t.Helper()
+
+	t.Run("memory", func(t *testing.T) {
+		test(t, NewMemoryStorage())
+	})
+
+	t.Run("postgres", func(t *testing.T) {
+		dsn := os.Getenv("TEST_DSN")
+		if dsn == "" {
+			t.Skip("TEST_DSN is not set")
+		}
+
+		t.Setenv("DSN", dsn)
+
+		database, err := ConnectDB()
+		if err != nil {
+			t.Fatalf("ConnectDB: %v", err)
+		}
+
+		t.Cleanup(func() { database.db.Close() })
+
+		ctx := context.Background()
+
+		if _, err := database.Migrate(ctx); err != nil {
+			t.Fatalf("Migrate: %v", err)
+		}
+
+		// every other table is emptied through its foreign keys.
+		if _, err := database.db.ExecContext(ctx, "TRUNCATE homeworks, stored_files CASCADE"); err != nil {
+			t.Fatalf("failed to empty the database: %v", err)
+		}
+
+		test(t, database)
+	})
+}
+
+// wantStorageCode fails the test unless the storage error maps to the given status code.
+func wantStorageCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+
+	if got := status.Code(statusError(err, "operation")); err == nil || got != code {
+		t.Fatalf("got %v mapping to %v, want %v", err, got, code)
+	}
+}
+
+func newStorageHomework(id string, dueDate time.Time) *hpb.Homework {
+	homework := &hpb.Homework{Id: id, CourseId: testCourse, Title: "Homework " + id}
+	if !dueDate.IsZero() {
+		homework.DueDate = timestamppb.New(dueDate)
+	}
+
+	return homework
+}
+
+func TestStorageHomeworks(t *testing.T) {
+	forEachStorage(t, func(t *testing.T, store Storage) {
+		ctx := context.Background()
+		homework := newStorageHomework("hw-1", time.Time{})
+
+		if err := store.AddHomework(ctx, homework); err != nil {
+			t.Fatalf("AddHomework: %v", err)
+		}
+
+		wantStorageCode(t, store.AddHomework(ctx, newStorageHomework("hw-1", time.Time{})), codes.AlreadyExists)
+
+		_, err := store.GetHomework(ctx, "missing")
+		wantStorageCode(t, err, codes.NotFound)
+
+		homework.Title = "Renamed"
+
+		updated, err := store.UpdateHomework(ctx, homework, nil)
+		if err != nil {
+			t.Fatalf("UpdateHomework: %v", err)
+		}
+
+		if updated.GetVersion() != 2 || updated.GetTitle() != "Renamed" || updated.GetCreatedAt() == nil {
+			t.Fatalf("UpdateHomework returned %v, want the renamed homework at version 2", updated)
+		}
+
+		stale := newStorageHomework("hw-1", time.Time{})
+		stale.Version = 1
+		_, err = store.UpdateHomework(ctx, stale, nil)
+		wantStorageCode(t, err, codes.Aborted)
+
+		missing := newStorageHomework("missing", time.Time{})
+		missing.Version = 1
+		_, err = store.UpdateHomework(ctx, missing, nil)
+		wantStorageCode(t, err, codes.NotFound)
+
+		// a masked update writes only its columns and returns the whole stored homework.
+		masked := &hpb.Homework{Id: "hw-1", Version: 2, Description: "Described"}
+
+		if updated, err = store.UpdateHomework(ctx, masked, []string{"description"}); err != nil {
+			t.Fatalf("UpdateHomework of description: %v", err)
+		}
+
+		got, err := store.GetHomework(ctx, "hw-1")
+		if err != nil {
+			t.Fatalf("GetHomework: %v", err)
+		}
+
+		if got.GetTitle() != "Renamed" || got.GetDescription() != "Described" || got.GetVersion() != 3 {
+			t.Fatalf("GetHomework = %v, want the renamed, described homework at version 3", got)
+		}
+
+		if !proto.Equal(updated, got) {
+			t.Fatalf("UpdateHomework returned %v, want the stored %v", updated, got)
+		}
+
+		if _, err := store.DeleteHomework(ctx, "hw-1"); err != nil {
+			t.Fatalf("DeleteHomework: %v", err)
+		}
+
+		_, err = store.DeleteHomework(ctx, "hw-1")
+		wantStorageCode(t, err, codes.NotFound)
+	})
+}
+
+func TestStorageSubmissionVersions(t *testing.T) {
+	forEachStorage(t, func(t *testing.T, store Storage) {
+		ctx := context.Background()
+
+		if err := store.AddHomework(ctx, newStorageHomework("hw-1", time.Time{})); err != nil {
+			t.Fatalf("AddHomework: %v", err)
+		}
+
+		wantStorageCode(t, store.AddSubmission(ctx, "missing", &hpb.Submission{StudentId: "student-1"}, 0),
+			codes.NotFound)
+
+		var first *hpb.Submission
+
+		for version := int32(1); version <= 2; version++ {
+			submission := &hpb.Submission{StudentId: "student-1", SubmissionTime: timestamppb.Now()}
+			if err := store.AddSubmission(ctx, "hw-1", submission, 2); err != nil {
+				t.Fatalf("AddSubmission: %v", err)
+			}
+
+			if submission.GetVersion() != version || !submission.GetFinal() {
+				t.Fatalf("AddSubmission made version %d (final %v), want final version %d",
+					submission.GetVersion(), submission.GetFinal(), version)
+			}
+
+			if first == nil {
+				first = submission
+			}
+		}
+
+		wantStorageCode(t, store.AddSubmission(ctx, "hw-1", &hpb.Submission{StudentId: "student-1"}, 2),
+			codes.FailedPrecondition)
+
+		if err := store.AddSubmission(ctx, "hw-1", &hpb.Submission{StudentId: "student-2"}, 2); err != nil {
+			t.Fatalf("AddSubmission of student-2: %v", err)
+		}
+
+		// selecting an older version makes it the only final one.
+		if _, err := store.SelectFinalSubmission(ctx, first.GetId()); err != nil {
+			t.Fatalf("SelectFinalSubmission: %v", err)
+		}
+
+		submissions, err := store.GetSubmissions(ctx, "hw-1", "student-1")
+		if err != nil {
+			t.Fatalf("GetSubmissions: %v", err)
+		}
+
+		if len(submissions) != 1 || submissions[0].GetId() != first.GetId() {
+			t.Fatalf("GetSubmissions of student-1 = %v, want the first version only", submissions)
+		}
+
+		if submissions, err = store.GetSubmissions(ctx, "hw-1", ""); err != nil || len(submissions) != 2 {
+			t.Fatalf("GetSubmissions = %v (%v), want the final versions of both students", submissions, err)
+		}
+
+		versions, err := store.ListSubmissionVersions(ctx, "hw-1", "student-1")
+		if err != nil {
+			t.Fatalf("ListSubmissionVersions: %v", err)
+		}
+
+		got := make([]int32, 0, len(versions))
+		for _, version := range versions {
+			got = append(got, version.GetVersion())
+		}
+
+		if !slices.Equal(got, []int32{1, 2}) {
+			t.Fatalf("ListSubmissionVersions = %v, want versions 1 and 2", got)
+		}
+
+		_, err = store.SelectFinalSubmission(ctx, "missing")
+		wantStorageCode(t, err, codes.NotFound)
+	})
+}
+
+func TestStorageGroups(t *testing.T) {
+	forEachStorage(t, func(t *testing.T, store Storage) {
+		ctx := context.Background()
+
+		if err := store.AddHomework(ctx, newStorageHomework("hw-1", time.Time{})); err != nil {
+			t.Fatalf("AddHomework: %v", err)
+		}
+
+		group := &hpb.Submission{StudentId: "student-1", PartnersId: []string{"student-2"}}
+		if err := store.AddSubmission(ctx, "hw-1", group, 0); err != nil {
+			t.Fatalf("AddSubmission: %v", err)
+		}
+
+		members, err := store.GroupMembers(ctx, "hw-1", "student-2")
+		if err != nil {
+			t.Fatalf("GroupMembers: %v", err)
+		}
+
+		slices.Sort(members)
+
+		if !slices.Equal(members, []string{"student-1", "student-2"}) {
+			t.Fatalf("GroupMembers = %v, want student-1 and student-2", members)
+		}
+
+		// a member of one group cannot join another.
+		conflict := &hpb.Submission{StudentId: "student-3", PartnersId: []string{"student-2"}}
+		wantStorageCode(t, store.AddSubmission(ctx, "hw-1", conflict, 0), codes.FailedPrecondition)
+
+		// the partner sees the group's submission as their own.
+		submissions, err := store.GetSubmissions(ctx, "hw-1", "student-2")
+		if err != nil || len(submissions) != 1 || submissions[0].GetId() != group.GetId() {
+			t.Fatalf("GetSubmissions of student-2 = %v (%v), want the group's submission", submissions, err)
+		}
+	})
+}
+
+func TestStorageListHomeworksOrder(t *testing.T) {
+	forEachStorage(t, func(t *testing.T, store Storage) {
+		ctx := context.Background()
+
+		// due dates past 2038 sort before undated homeworks, which come last in either direction.
+		for id, dueDate := range map[string]time.Time{
+			"a": time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			"b": time.Date(2040, 1, 1, 0, 0, 0, 0, time.UTC),
+			"c": {},
+			"e": {},
+			"d": time.Date(2035, 1, 1, 0, 0, 0, 0, time.UTC),
+		} {
+			if err := store.AddHomework(ctx, newStorageHomework(id, dueDate)); err != nil {
+				t.Fatalf("AddHomework: %v", err)
+			}
+		}
+
+		list := func(filter *HomeworkFilter) []string {
+			t.Helper()
+
+			var ids []string
+
+			for {
+				homeworks, next, err := store.ListHomeworks(ctx, filter)
+				if err != nil {
+					t.Fatalf("ListHomeworks: %v", err)
+				}
+
+				for _, homework := range homeworks {
+					ids = append(ids, homework.GetId())
+				}
+
+				if next == nil {
+					return ids
+				}
+
+				filter.After = next
+			}
+		}
+
+		if got := list(&HomeworkFilter{CourseID: testCourse, Limit: 2}); !slices.Equal(got,
+			[]string{"a", "d", "b", "c", "e"}) {
+			t.Fatalf("ListHomeworks by due date = %v, want [a d b c e]", got)
+		}
+
+		for limit := 1; limit <= 3; limit++ {
+			if got := list(&HomeworkFilter{CourseID: testCourse, Limit: limit, Descending: true}); !slices.Equal(got,
+				[]string{"b", "d", "a", "e", "c"}) {
+				t.Fatalf("ListHomeworks by due date descending, %d per page = %v, want [b d a e c]", limit, got)
+			}
+		}
+	})
+}
+
+func TestStorageResponsesAreCopies(t *testing.T) {
+	forEachStorage(t, func(t *testing.T, store Storage) {
+		ctx := context.Background()
+
+		homework := newStorageHomework("hw-1", time.Time{})
+		homework.WorkflowDefinition = &hpb.Workflow{Steps: []string{"draft", "submit"}}
+
+		if err := store.AddHomework(ctx, homework); err != nil {
+			t.Fatalf("AddHomework: %v", err)
+		}
+
+		got, err := store.GetHomework(ctx, "hw-1")
+		if err != nil {
+			t.Fatalf("GetHomework: %v", err)
+		}
+
+		got.GetWorkflowDefinition().GetSteps()[0] = "changed"
+
+		submission := &hpb.Submission{StudentId: "student-1", PartnersId: []string{"student-2"}}
+		if err := store.AddSubmission(ctx, "hw-1", submission, 0); err != nil {
+			t.Fatalf("AddSubmission: %v", err)
+		}
+
+		stored, err := store.GetSubmission(ctx, submission.GetId())
+		if err != nil {
+			t.Fatalf("GetSubmission: %v", err)
+		}
+
+		stored.GetPartnersId()[0] = "changed"
+
+		if got, err = store.GetHomework(ctx, "hw-1"); err != nil || got.GetWorkflowDefinition().GetSteps()[0] != "draft" {
+			t.Fatalf("changing a returned homework changed the stored one: %v, %v", got, err)
+		}
+
+		if stored, err = store.GetSubmission(ctx, submission.GetId()); err != nil ||
+			stored.GetPartnersId()[0] != "student-2" {
+			t.Fatalf("changing a returned submission changed the stored one: %v, %v", stored, err)
+		}
+	})
+}
+
+func TestStorageRegrades(t *testing.T) {
+	forEachStorage(t, func(t *testing.T, store Storage) {
+		ctx := context.Background()
+
+		if err := store.AddHomework(ctx, newStorageHomework("hw-1", time.Time{})); err != nil {
+			t.Fatalf("AddHomework: %v", err)
+		}
+
+		submission := &hpb.Submission{StudentId: "student-1"}
+		if err := store.AddSubmission(ctx, "hw-1", submission, 0); err != nil {
+			t.Fatalf("AddSubmission: %v", err)
+		}
+
+		_, err := store.AddRegrade(ctx, &hpb.Regrade{SubmissionId: submission.GetId(), StudentId: "student-1"})
+		wantStorageCode(t, err, codes.FailedPrecondition)
+
+		if _, err := store.SetGrade(ctx, &hpb.Grade{
+			SubmissionId: submission.GetId(),
+			Score:        5,
+			MaxScore:     10,
+			Selections:   []*hpb.CriterionSelection{{CriterionId: "correctness", LevelId: "partial", Points: 5}},
+		}); err != nil {
+			t.Fatalf("SetGrade: %v", err)
+		}
+
+		regrade, err := store.AddRegrade(ctx, &hpb.Regrade{SubmissionId: submission.GetId(), StudentId: "student-1"})
+		if err != nil {
+			t.Fatalf("AddRegrade: %v", err)
+		}
+
+		_, err = store.AddRegrade(ctx, &hpb.Regrade{SubmissionId: submission.GetId(), StudentId: "student-1"})
+		wantStorageCode(t, err, codes.AlreadyExists)
+
+		resolved, grade, err := store.ResolveRegrade(ctx, &hpb.Regrade{
+			Id:            regrade.GetId(),
+			Status:        hpb.RegradeStatus_REGRADE_STATUS_ACCEPTED,
+			AdjustedScore: proto.Float64(8),
+		})
+		if err != nil {
+			t.Fatalf("ResolveRegrade: %v", err)
+		}
+
+		if resolved.GetStatus() != hpb.RegradeStatus_REGRADE_STATUS_ACCEPTED || grade.GetScore() != 8 ||
+			len(grade.GetSelections()) != 0 {
+			t.Fatalf("ResolveRegrade = %v, %v, want accepted with score 8 and no selections", resolved, grade)
+		}
+
+		_, _, err = store.ResolveRegrade(ctx, &hpb.Regrade{
+			Id:     regrade.GetId(),
+			Status: hpb.RegradeStatus_REGRADE_STATUS_REJECTED,
+		})
+		wantStorageCode(t, err, codes.FailedPrecondition)
+	})
+}
+
+func TestMemoryStorageResolveRegradeWithoutGrade(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStorage()
+
+	if err := store.AddHomework(ctx, newStorageHomework("hw-1", time.Time{})); err != nil {
+		t.Fatalf("AddHomework: %v", err)
+	}
+
+	submission := &hpb.Submission{StudentId: "student-1"}
+	if err := store.AddSubmission(ctx, "hw-1", submission, 0); err != nil {
+		t.Fatalf("AddSubmission: %v", err)
+	}
+
+	if _, err := store.SetGrade(ctx, &hpb.Grade{SubmissionId: submission.GetId(), Score: 5, MaxScore: 10}); err != nil {
+		t.Fatalf("SetGrade: %v", err)
+	}
+
+	regrade, err := store.AddRegrade(ctx, &hpb.Regrade{SubmissionId: submission.GetId(), StudentId: "student-1"})
+	if err != nil {
+		t.Fatalf("AddRegrade: %v", err)
+	}
+
+	// the grade disappears between the request and its resolution.
+	delete(store.grades, submission.GetId())
+
+	if _, _, err := store.ResolveRegrade(ctx, &hpb.Regrade{
+		Id:            regrade.GetId(),
+		Status:        hpb.RegradeStatus_REGRADE_STATUS_ACCEPTED,
+		AdjustedScore: proto.Float64(8),
+	}); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("ResolveRegrade without a grade returned %v, want sql.ErrNoRows", err)
+	}
+
+	got, err := store.GetRegrade(ctx, regrade.GetId())
+	if err != nil {
+		t.Fatalf("GetRegrade: %v", err)
+	}
+
+	if got.GetStatus() != hpb.RegradeStatus_REGRADE_STATUS_OPEN || got.AdjustedScore != nil {
+		t.Fatalf("the failed resolution changed regrade %v", got)
+	}
+}
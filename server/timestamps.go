@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// legacyTimeLayouts are the formats accepted for timestamps that older clients
+// and older database rows carry as free-form strings. Values without a zone are read as UTC.
+var legacyTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	time.DateOnly,
+}
+
+// parseLegacyTime parses a timestamp given as a free-form string.
+func parseLegacyTime(value string) (time.Time, error) {
+	for _, layout := range legacyTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%q is not an RFC 3339 timestamp or a YYYY-MM-DD date", value)
+}
+
+// formatLegacyTime formats a timestamp for the deprecated string fields, empty for the zero time.
+func formatLegacyTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+// toTimestamp converts a time into a timestamp message, nil for the zero time.
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+// fromTimestamp converts a timestamp message into a time, the zero time for nil.
+func fromTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
+
+// resolveTimestamp returns the timestamp of a field that has a typed and a deprecated
+// string form, preferring the typed one, and validates it.
+func resolveTimestamp(typed *timestamppb.Timestamp, legacy string) (*timestamppb.Timestamp, error) {
+	if typed == nil {
+		if legacy == "" {
+			return nil, nil
+		}
+
+		t, err := parseLegacyTime(legacy)
+		if err != nil {
+			return nil, err
+		}
+
+		return timestamppb.New(t), nil
+	}
+
+	if err := typed.CheckValid(); err != nil {
+		return nil, fmt.Errorf("invalid timestamp: %w", err)
+	}
+
+	return typed, nil
+}
+
+// normalizeDueDate resolves the due date of a homework from its typed or deprecated
+// string field and fills in both forms.
+func normalizeDueDate(homework *hpb.Homework) error {
+	//nolint:staticcheck // accepted from older clients.
+	dueDate, err := resolveTimestamp(homework.GetDueDate(), homework.GetDueDateText())
+	if err != nil {
+		return fmt.Errorf("invalid dueDate: %w", err)
+	}
+
+	homework.DueDate = dueDate
+	homework.DueDateText = formatLegacyTime(fromTimestamp(dueDate)) //nolint:staticcheck // filled in for older clients.
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestParseLegacyTime(t *testing.T) {
+	tests := map[string]time.Time{
+		"2026-10-17T12:30:00+02:00": time.Date(2026, 10, 17, 10, 30, 0, 0, time.UTC),
+		"2026-10-17T12:30:00":       time.Date(2026, 10, 17, 12, 30, 0, 0, time.UTC),
+		"2026-10-17 12:30":          time.Date(2026, 10, 17, 12, 30, 0, 0, time.UTC),
+		"2026-10-17":                time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC),
+	}
+
+	for value, want := range tests {
+		if got, err := parseLegacyTime(value); err != nil || !got.Equal(want) {
+			t.Errorf("parseLegacyTime(%q) = %v (%v), want %v", value, got, err, want)
+		}
+	}
+
+	if _, err := parseLegacyTime("next friday"); err == nil {
+		t.Error("parseLegacyTime accepted a free-form date")
+	}
+}
+
+//nolint:staticcheck // the deprecated string form is filled in too.
+func TestNormalizeDueDate(t *testing.T) {
+	due := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	// the typed field wins over the string one.
+	homework := &hpb.Homework{DueDate: timestamppb.New(due), DueDateText: "2000-01-01"}
+	if err := normalizeDueDate(homework); err != nil {
+		t.Fatalf("normalizeDueDate: %v", err)
+	}
+
+	if !homework.GetDueDate().AsTime().Equal(due) || homework.GetDueDateText() != "2026-10-17T12:00:00Z" {
+		t.Fatalf("normalized to %v and %q", homework.GetDueDate().AsTime(), homework.GetDueDateText())
+	}
+
+	homework = &hpb.Homework{DueDateText: "2026-10-17T12:00"}
+	if err := normalizeDueDate(homework); err != nil || !homework.GetDueDate().AsTime().Equal(due) {
+		t.Fatalf("normalized %q to %v (%v)", "2026-10-17T12:00", homework.GetDueDate(), err)
+	}
+
+	homework = &hpb.Homework{}
+	if err := normalizeDueDate(homework); err != nil || homework.GetDueDate() != nil || homework.GetDueDateText() != "" {
+		t.Fatalf("normalized an undated homework to %v and %q (%v)", homework.GetDueDate(),
+			homework.GetDueDateText(), err)
+	}
+
+	for _, homework := range []*hpb.Homework{
+		{DueDateText: "tomorrow"},
+		{DueDate: &timestamppb.Timestamp{Nanos: -1}},
+	} {
+		if err := normalizeDueDate(homework); err == nil {
+			t.Errorf("normalizeDueDate accepted %v", homework)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"k8s.io/klog/v2"
+)
+
+// ListSubmissionVersions lists every version a student submitted for a homework, oldest first.
+func (s *HomeworkServer) ListSubmissionVersions(ctx context.Context,
+	req *hpb.ListSubmissionVersionsRequest,
+) (*hpb.ListSubmissionVersionsResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received ListSubmissionVersions request", "homeworkId", req.GetHomeworkId(),
+		"studentId", req.GetStudentId())
+
+	if req.GetHomeworkId() == "" {
+		return nil, invalidArgument("homeworkId", "homeworkId is empty")
+	}
+
+	if req.GetStudentId() == "" {
+		return nil, invalidArgument("studentId", "studentId is empty")
+	}
+
+	if _, err := s.authorizeHomework(ctx, req.GetHomeworkId(), func(courseID string) bool {
+		return caller.canView(courseID, req.GetStudentId())
+	}); err != nil {
+		return nil, err
+	}
+
+	// get the versions from the database.
+	submissions, err := s.db.ListSubmissionVersions(ctx, req.GetHomeworkId(), req.GetStudentId())
+	if err != nil {
+		logger.Error(err, "failed to list submission versions", "homeworkId", req.GetHomeworkId())
+		return nil, statusError(err, "failed to list submission versions")
+	}
+
+	logger.V(logLevelDebug).Info("Successfully listed submission versions", "homeworkId", req.GetHomeworkId(),
+		"studentId", req.GetStudentId(), "count", len(submissions))
+
+	return &hpb.ListSubmissionVersionsResponse{Submissions: submissions}, nil
+}
+
+// SelectFinalSubmission marks a submitted version as the one that counts for grading.
+func (s *HomeworkServer) SelectFinalSubmission(ctx context.Context,
+	req *hpb.SelectFinalSubmissionRequest,
+) (*hpb.SelectFinalSubmissionResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received SelectFinalSubmission request", "submissionId", req.GetSubmissionId())
+
+	if req.GetSubmissionId() == "" {
+		return nil, invalidArgument("submissionId", "submissionId is empty")
+	}
+
+	// staff may select on a student's behalf; students only among their own versions.
+	if _, _, err := s.authorizeSubmission(ctx, req.GetSubmissionId(),
+		func(courseID string, submission *hpb.Submission) bool {
+			return caller.isStaff(courseID) || caller.ownsSubmission(courseID, submission)
+		}); err != nil {
+		return nil, err
+	}
+
+	submission, err := s.db.SelectFinalSubmission(ctx, req.GetSubmissionId())
+	if err != nil {
+		logger.Error(err, "failed to select final submission", "submissionId", req.GetSubmissionId())
+
+		return nil, statusError(err, "failed to select submission %s", req.GetSubmissionId())
+	}
+
+	logger.V(logLevelDebug).Info("Successfully selected final submission", "submissionId", submission.GetId(),
+		"version", submission.GetVersion())
+
+	return &hpb.SelectFinalSubmissionResponse{Submission: submission}, nil
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"testing"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc/codes"
+)
+
+func TestVersionRequestValidation(t *testing.T) {
+	// every request below is rejected before the database is reached.
+	server, ctx := newValidationServer(t)
+
+	tests := []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{"negative max attempts", func() error {
+			_, err := server.CreateHomework(ctx, &hpb.CreateHomeworkRequest{
+				Homework: &hpb.Homework{Id: "hw-1", MaxAttempts: -1},
+			})
+			return err
+		}, codes.InvalidArgument},
+		{"versions without a student", func() error {
+			_, err := server.ListSubmissionVersions(ctx, &hpb.ListSubmissionVersionsRequest{
+				HomeworkId: "hw-1",
+			})
+			return err
+		}, codes.InvalidArgument},
+		{"select no submission", func() error {
+			_, err := server.SelectFinalSubmission(ctx, &hpb.SelectFinalSubmissionRequest{})
+			return err
+		}, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantCode(t, tt.call(), tt.want)
+		})
+	}
+}
+
+func TestSubmissionVersions(t *testing.T) {
+	homework := newTestHomework("hw-1")
+	homework.MaxAttempts = 0
+
+	course := newCourseTest(t, homework)
+	client, staff, student := course.client, course.staff, course.student
+
+	var ids []string
+
+	for range 3 {
+		resp, err := client.SubmitHomework(student, &hpb.SubmitHomeworkRequest{
+			Id:         "hw-1",
+			Submission: &hpb.Submission{StudentId: "student-1"},
+		})
+		if err != nil {
+			t.Fatalf("SubmitHomework: %v", err)
+		}
+
+		ids = append(ids, resp.GetSubmission().GetId())
+	}
+
+	// finals lists the versions and returns the final ones.
+	finals := func() []int32 {
+		t.Helper()
+
+		resp, err := client.ListSubmissionVersions(student, &hpb.ListSubmissionVersionsRequest{
+			HomeworkId: "hw-1", StudentId: "student-1",
+		})
+		if err != nil {
+			t.Fatalf("ListSubmissionVersions: %v", err)
+		}
+
+		var versions []int32
+
+		for i, submission := range resp.GetSubmissions() {
+			if submission.GetVersion() != int32(i+1) {
+				t.Fatalf("ListSubmissionVersions returned version %d at %d", submission.GetVersion(), i)
+			}
+
+			if submission.GetFinal() {
+				versions = append(versions, submission.GetVersion())
+			}
+		}
+
+		return versions
+	}
+
+	if got := finals(); len(got) != 1 || got[0] != 3 {
+		t.Fatalf("final versions = %v, want the latest", got)
+	}
+
+	selected, err := client.SelectFinalSubmission(student, &hpb.SelectFinalSubmissionRequest{SubmissionId: ids[0]})
+	if err != nil {
+		t.Fatalf("SelectFinalSubmission: %v", err)
+	}
+
+	if !selected.GetSubmission().GetFinal() || selected.GetSubmission().GetVersion() != 1 {
+		t.Fatalf("SelectFinalSubmission returned %v", selected.GetSubmission())
+	}
+
+	if got := finals(); len(got) != 1 || got[0] != 1 {
+		t.Fatalf("final versions = %v, want the selected one", got)
+	}
+
+	// the selected version is the one everybody gets.
+	got, err := client.GetSubmissions(staff, &hpb.GetSubmissionsRequest{HomeworkId: "hw-1"})
+	if err != nil {
+		t.Fatalf("GetSubmissions: %v", err)
+	}
+
+	if len(got.GetSubmissions()) != 1 || got.GetSubmissions()[0].GetId() != ids[0] {
+		t.Fatalf("GetSubmissions returned %v, want the selected version", got.GetSubmissions())
+	}
+
+	// staff may select on the student's behalf.
+	if _, err := client.SelectFinalSubmission(staff, &hpb.SelectFinalSubmissionRequest{
+		SubmissionId: ids[1],
+	}); err != nil {
+		t.Fatalf("SelectFinalSubmission by staff: %v", err)
+	}
+
+	if got := finals(); len(got) != 1 || got[0] != 2 {
+		t.Fatalf("final versions = %v, want the one selected by staff", got)
+	}
+
+	_, err = client.SelectFinalSubmission(student, &hpb.SelectFinalSubmissionRequest{SubmissionId: "unknown"})
+	wantCode(t, err, codes.NotFound)
+}
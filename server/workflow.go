@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// validateWorkflow checks that a workflow's steps are named and named uniquely.
+func validateWorkflow(workflow *hpb.Workflow) error {
+	if workflow == nil {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(workflow.GetSteps()))
+
+	for _, step := range workflow.GetSteps() {
+		if step == "" {
+			return errors.New("step names must not be empty")
+		}
+
+		if seen[step] {
+			return fmt.Errorf("step %q is defined more than once", step)
+		}
+
+		seen[step] = true
+	}
+
+	return nil
+}
+
+// nextWorkflowStep returns the first of the steps not completed yet, and false once every
+// step is. Completions are matched to steps by name, so progress stays put when staff add,
+// remove or reorder steps.
+func nextWorkflowStep(steps []string, completed []StepCompletion) (string, bool) {
+	for _, step := range steps {
+		if !slices.ContainsFunc(completed, func(c StepCompletion) bool { return c.Step == step }) {
+			return step, true
+		}
+	}
+
+	return "", false
+}
+
+// fillNextStep sets the next step of the progress against the workflow's steps, leaving
+// out completions of steps the workflow no longer has.
+func fillNextStep(progress *hpb.WorkflowProgress, steps []string) {
+	completed := make([]*hpb.StepCompletion, 0, len(progress.GetCompletedSteps()))
+	completions := make([]StepCompletion, 0, len(progress.GetCompletedSteps()))
+
+	for _, completion := range progress.GetCompletedSteps() {
+		if slices.Contains(steps, completion.GetStep()) {
+			completed = append(completed, completion)
+			completions = append(completions, StepCompletion{Step: completion.GetStep()})
+		}
+	}
+
+	progress.CompletedSteps = completed
+	next, ok := nextWorkflowStep(steps, completions)
+	progress.NextStep = next
+	progress.Done = !ok
+}
+
+// workflowHomework retrieves a homework that has a workflow, mapping failures to status errors.
+func (s *HomeworkServer) workflowHomework(ctx context.Context, id string) (*hpb.Homework, error) {
+	homework, err := s.db.GetHomework(ctx, id)
+	if err != nil {
+		klog.FromContext(ctx).Error(err, "failed to get homework", "id", id)
+
+		return nil, statusError(err, "failed to get homework %s", id)
+	}
+
+	if homework.GetWorkflowDefinition() == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "homework %s has no workflow", id)
+	}
+
+	return homework, nil
+}
+
+// AdvanceWorkflow completes the next step of a homework's workflow for a student.
+func (s *HomeworkServer) AdvanceWorkflow(ctx context.Context,
+	req *hpb.AdvanceWorkflowRequest,
+) (*hpb.AdvanceWorkflowResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received AdvanceWorkflow request", "homeworkId", req.GetHomeworkId(),
+		"studentId", req.GetStudentId(), "step", req.GetStep())
+
+	if req.GetHomeworkId() == "" {
+		return nil, invalidArgument("homeworkId", "homeworkId is empty")
+	}
+
+	if req.GetStudentId() == "" {
+		return nil, invalidArgument("studentId", "studentId is empty")
+	}
+
+	if req.GetStep() == "" {
+		return nil, invalidArgument("step", "step is empty")
+	}
+
+	homework, err := s.workflowHomework(ctx, req.GetHomeworkId())
+	if err != nil {
+		return nil, err
+	}
+
+	// staff may advance any student; students only themselves.
+	if !caller.canView(homework.GetCourseId(), req.GetStudentId()) {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to advance the workflow of student %s",
+			req.GetStudentId())
+	}
+
+	steps := homework.GetWorkflowDefinition().GetSteps()
+	if !slices.Contains(steps, req.GetStep()) {
+		return nil, invalidArgument("step", "homework %s has no step %q",
+			req.GetHomeworkId(), req.GetStep())
+	}
+
+	progress, err := s.db.AdvanceWorkflow(ctx, req.GetHomeworkId(), req.GetStudentId(), req.GetStep(),
+		steps, s.now())
+	if err != nil {
+		logger.Error(err, "failed to advance workflow", "homeworkId", req.GetHomeworkId())
+
+		return nil, statusError(err, "failed to complete step %q", req.GetStep())
+	}
+
+	fillNextStep(progress, steps)
+
+	logger.V(logLevelDebug).Info("Successfully advanced workflow", "homeworkId", req.GetHomeworkId(),
+		"studentId", req.GetStudentId(), "nextStep", progress.GetNextStep())
+
+	return &hpb.AdvanceWorkflowResponse{Progress: progress}, nil
+}
+
+// GetWorkflowProgress retrieves the workflow progress of one student, or of every student who started it.
+func (s *HomeworkServer) GetWorkflowProgress(ctx context.Context,
+	req *hpb.GetWorkflowProgressRequest,
+) (*hpb.GetWorkflowProgressResponse, error) {
+	caller, err := callerFrom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := klog.FromContext(ctx)
+	logger.V(logLevelDebug).Info("Received GetWorkflowProgress request", "homeworkId", req.GetHomeworkId(),
+		"studentId", req.GetStudentId())
+
+	homework, err := s.workflowHomework(ctx, req.GetHomeworkId())
+	if err != nil {
+		return nil, err
+	}
+
+	// the progress of every student is for staff only.
+	if !caller.isStaff(homework.GetCourseId()) &&
+		(req.GetStudentId() == "" || !caller.canView(homework.GetCourseId(), req.GetStudentId())) {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to view the workflow progress of homework %s",
+			req.GetHomeworkId())
+	}
+
+	progress, err := s.db.ListWorkflowProgress(ctx, req.GetHomeworkId(), req.GetStudentId())
+	if err != nil {
+		logger.Error(err, "failed to list workflow progress", "homeworkId", req.GetHomeworkId())
+		return nil, statusError(err, "failed to list workflow progress")
+	}
+
+	// a student who has not started the workflow is reported at its first step.
+	if req.GetStudentId() != "" && len(progress) == 0 {
+		progress = append(progress, &hpb.WorkflowProgress{
+			HomeworkId: req.GetHomeworkId(),
+			StudentId:  req.GetStudentId(),
+		})
+	}
+
+	for _, p := range progress {
+		fillNextStep(p, homework.GetWorkflowDefinition().GetSteps())
+	}
+
+	logger.V(logLevelDebug).Info("Successfully fetched workflow progress", "homeworkId", req.GetHomeworkId(),
+		"count", len(progress))
+
+	return &hpb.GetWorkflowProgressResponse{Progress: progress}, nil
+}
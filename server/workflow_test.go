@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	hpb "github.com/BetterGR/homework-microservice/protos"
+	"google.golang.org/grpc/codes"
+)
+
+func TestValidateWorkflow(t *testing.T) {
+	for _, workflow := range []*hpb.Workflow{nil, {}, {Steps: []string{"draft", "review", "final"}}} {
+		if err := validateWorkflow(workflow); err != nil {
+			t.Errorf("validateWorkflow(%v) = %v", workflow, err)
+		}
+	}
+
+	for _, workflow := range []*hpb.Workflow{{Steps: []string{"draft", ""}}, {Steps: []string{"draft", "draft"}}} {
+		if err := validateWorkflow(workflow); err == nil {
+			t.Errorf("validateWorkflow accepted %v", workflow)
+		}
+	}
+}
+
+func TestFillNextStep(t *testing.T) {
+	steps := []string{"draft", "review"}
+	progress := &hpb.WorkflowProgress{}
+
+	fillNextStep(progress, steps)
+
+	if progress.GetNextStep() != "draft" || progress.GetDone() {
+		t.Fatalf("fresh progress = %v, want draft next", progress)
+	}
+
+	progress.CompletedSteps = []*hpb.StepCompletion{{Step: "draft"}, {Step: "review"}}
+	fillNextStep(progress, steps)
+
+	if progress.GetNextStep() != "" || !progress.GetDone() {
+		t.Fatalf("completed progress = %v, want done", progress)
+	}
+}
+
+func TestAdvanceWorkflowRequestValidation(t *testing.T) {
+	server, ctx := newValidationServer(t)
+
+	_, err := server.AdvanceWorkflow(ctx, &hpb.AdvanceWorkflowRequest{HomeworkId: "hw-1", StudentId: "student-1"})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func TestWorkflowOrder(t *testing.T) {
+	homework := newTestHomework("hw-1")
+	homework.WorkflowDefinition = &hpb.Workflow{Steps: []string{"draft", "review", "submit"}}
+
+	course := newCourseTest(t, homework, newTestHomework("hw-2"))
+	client, staff, student := course.client, course.staff, course.student
+	other := course.asStudent(t, "student-2")
+
+	advance := func(ctx context.Context, studentID, step string) (*hpb.WorkflowProgress, error) {
+		resp, err := client.AdvanceWorkflow(ctx, &hpb.AdvanceWorkflowRequest{
+			HomeworkId: "hw-1", StudentId: studentID, Step: step,
+		})
+
+		return resp.GetProgress(), err
+	}
+
+	got, err := client.GetWorkflowProgress(student, &hpb.GetWorkflowProgressRequest{
+		HomeworkId: "hw-1", StudentId: "student-1",
+	})
+	if err != nil {
+		t.Fatalf("GetWorkflowProgress: %v", err)
+	}
+
+	if got.GetProgress()[0].GetNextStep() != "draft" {
+		t.Fatalf("GetWorkflowProgress before starting = %v, want draft next", got.GetProgress()[0])
+	}
+
+	_, err = advance(student, "student-1", "review")
+	wantCode(t, err, codes.FailedPrecondition)
+
+	_, err = advance(student, "student-1", "publish")
+	wantCode(t, err, codes.InvalidArgument)
+
+	_, err = advance(other, "student-1", "draft")
+	wantCode(t, err, codes.PermissionDenied)
+
+	for _, step := range []string{"draft", "review"} {
+		if _, err := advance(student, "student-1", step); err != nil {
+			t.Fatalf("AdvanceWorkflow %s: %v", step, err)
+		}
+	}
+
+	_, err = advance(student, "student-1", "draft")
+	wantCode(t, err, codes.FailedPrecondition)
+
+	// staff may complete a step on the student's behalf.
+	done, err := advance(staff, "student-1", "submit")
+	if err != nil {
+		t.Fatalf("AdvanceWorkflow submit: %v", err)
+	}
+
+	if !done.GetDone() || done.GetNextStep() != "" || len(done.GetCompletedSteps()) != 3 {
+		t.Fatalf("AdvanceWorkflow of the last step = %v, want the workflow done", done)
+	}
+
+	_, err = advance(student, "student-1", "submit")
+	wantCode(t, err, codes.FailedPrecondition)
+
+	if _, err := advance(other, "student-2", "draft"); err != nil {
+		t.Fatalf("AdvanceWorkflow: %v", err)
+	}
+
+	all, err := client.GetWorkflowProgress(staff, &hpb.GetWorkflowProgressRequest{HomeworkId: "hw-1"})
+	if err != nil {
+		t.Fatalf("GetWorkflowProgress: %v", err)
+	}
+
+	if len(all.GetProgress()) != 2 || all.GetProgress()[0].GetStudentId() != "student-1" ||
+		all.GetProgress()[1].GetNextStep() != "review" {
+		t.Fatalf("GetWorkflowProgress of every student = %v", all.GetProgress())
+	}
+
+	_, err = client.GetWorkflowProgress(student, &hpb.GetWorkflowProgressRequest{HomeworkId: "hw-1"})
+	wantCode(t, err, codes.PermissionDenied)
+
+	_, err = client.AdvanceWorkflow(student, &hpb.AdvanceWorkflowRequest{
+		HomeworkId: "hw-2", StudentId: "student-1", Step: "draft",
+	})
+	wantCode(t, err, codes.FailedPrecondition)
+}
+
+func TestWorkflowProgressAfterStepsChange(t *testing.T) {
+	course := newCourseTest(t)
+	client, staff, student := course.client, course.staff, course.student
+
+	homework := newTestHomework("hw-1")
+	homework.WorkflowDefinition = &hpb.Workflow{Steps: []string{"draft", "review", "submit"}}
+
+	created, err := client.CreateHomework(staff, &hpb.CreateHomeworkRequest{Homework: homework})
+	if err != nil {
+		t.Fatalf("CreateHomework: %v", err)
+	}
+
+	if _, err := client.AdvanceWorkflow(student, &hpb.AdvanceWorkflowRequest{
+		HomeworkId: "hw-1", StudentId: "student-1", Step: "draft",
+	}); err != nil {
+		t.Fatalf("AdvanceWorkflow: %v", err)
+	}
+
+	// staff add a step before the completed one and drop another.
+	update := created.GetHw()
+	update.WorkflowDefinition = &hpb.Workflow{Steps: []string{"outline", "draft", "submit"}}
+
+	if _, err := client.UpdateHomework(staff, &hpb.UpdateHomeworkRequest{Homework: update}); err != nil {
+		t.Fatalf("UpdateHomework: %v", err)
+	}
+
+	got, err := client.GetWorkflowProgress(student, &hpb.GetWorkflowProgressRequest{
+		HomeworkId: "hw-1", StudentId: "student-1",
+	})
+	if err != nil {
+		t.Fatalf("GetWorkflowProgress: %v", err)
+	}
+
+	progress := got.GetProgress()[0]
+	if progress.GetNextStep() != "outline" || len(progress.GetCompletedSteps()) != 1 ||
+		progress.GetCompletedSteps()[0].GetStep() != "draft" {
+		t.Fatalf("GetWorkflowProgress = %v, want draft completed and outline next", progress)
+	}
+
+	_, err = client.AdvanceWorkflow(student, &hpb.AdvanceWorkflowRequest{
+		HomeworkId: "hw-1", StudentId: "student-1", Step: "submit",
+	})
+	wantCode(t, err, codes.FailedPrecondition)
+
+	advanced, err := client.AdvanceWorkflow(student, &hpb.AdvanceWorkflowRequest{
+		HomeworkId: "hw-1", StudentId: "student-1", Step: "outline",
+	})
+	if err != nil {
+		t.Fatalf("AdvanceWorkflow: %v", err)
+	}
+
+	// the draft completed before the change still counts.
+	if advanced.GetProgress().GetNextStep() != "submit" {
+		t.Fatalf("AdvanceWorkflow left next step %q, want submit", advanced.GetProgress().GetNextStep())
+	}
+}